@@ -2,32 +2,89 @@ package bitbucket
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"math/rand"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"golang.org/x/oauth2"
+	oauth2bitbucket "golang.org/x/oauth2/bitbucket"
+	oauth2clientcreds "golang.org/x/oauth2/clientcredentials"
 )
 
 // Error represents a error from the bitbucket api.
 type Error struct {
 	APIError struct {
 		Message string `json:"message,omitempty"`
+		// Detail explains the message, e.g. which field was invalid.
+		Detail string `json:"detail,omitempty"`
 	} `json:"error,omitempty"`
-	Type       string `json:"type,omitempty"`
-	StatusCode int
-	Endpoint   string
+	Type        string `json:"type,omitempty"`
+	StatusCode  int
+	Endpoint    string
+	ContentType string `json:"-"`
+	// RawBody is the complete body of the error response
+	RawBody []byte `json:"-"`
 }
 
+// maxErrorMessageLength caps how much of a non-JSON error body, like the HTML
+// page of a proxy, ends up in the error message.
+const maxErrorMessageLength = 2048
+
 func (e Error) Error() string {
+	if e.APIError.Detail != "" {
+		return fmt.Sprintf("API Error: %d %s %s: %s", e.StatusCode, e.Endpoint, e.APIError.Message, e.APIError.Detail)
+	}
+
 	return fmt.Sprintf("API Error: %d %s %s", e.StatusCode, e.Endpoint, e.APIError.Message)
 }
 
+func truncate(message string, length int) string {
+	if len(message) <= length {
+		return message
+	}
+
+	return message[:length] + "... (truncated)"
+}
+
+// IsNotFound reports whether err is an Error for a 404 response, so reads can
+// remove resources that were deleted outside of terraform from state.
+func IsNotFound(err error) bool {
+	var apiError Error
+	if errors.As(err, &apiError) {
+		return apiError.StatusCode == http.StatusNotFound
+	}
+
+	return false
+}
+
 const (
-	// BitbucketEndpoint is the fqdn used to talk to bitbucket
+	// BitbucketEndpoint is the fqdn used to talk to bitbucket cloud
 	BitbucketEndpoint string = "https://api.bitbucket.org/"
+
+	// DefaultMaxRetries is the number of times a rate limited or failed request is retried
+	DefaultMaxRetries int = 3
+
+	// DefaultMaxPages caps how many pages of a collection are followed
+	DefaultMaxPages int = 1000
+
+	// DefaultRetryWaitMin is the base delay of the exponential backoff between retries
+	DefaultRetryWaitMin time.Duration = 500 * time.Millisecond
+
+	// DefaultRetryWaitMax caps the delay of the exponential backoff between retries
+	DefaultRetryWaitMax time.Duration = 10 * time.Second
+
+	// DefaultDeleteConcurrency is the number of DELETE requests in flight at once
+	DefaultDeleteConcurrency int = 4
 )
 
 // Client is the base internal Client to talk to bitbuckets API. This should be a username and password
@@ -38,21 +95,371 @@ type Client struct {
 	OAuthToken       *string
 	OAuthTokenSource oauth2.TokenSource
 	HTTPClient       *http.Client
+	// TokenURL is the OAuth token endpoint used by UseClientCredentials, it
+	// defaults to the Bitbucket cloud token endpoint.
+	TokenURL string
+	// UserAgent is sent with every request when set.
+	UserAgent string
+	// BaseURL is the API root requests are sent to, it defaults to
+	// BitbucketEndpoint and can point at a proxy in front of the Bitbucket
+	// Cloud API instead. Endpoints are always the 2.0 Cloud ones.
+	BaseURL string
+	// MaxRetries is the number of times a request is retried when
+	// Bitbucket responds with 429 Too Many Requests or a transient 5xx.
+	MaxRetries int
+	// RetryWaitMin is the base delay used for exponential backoff, it is
+	// doubled on every attempt up to RetryWaitMax.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+	// MaxPages caps how many pages GetPaged and GetAllValues follow before
+	// giving up, DefaultMaxPages is used when zero.
+	MaxPages int
+	// Timeout bounds every attempt of a request, including reading the
+	// response body. Zero means no timeout.
+	Timeout time.Duration
+	// DeleteConcurrency caps how many DELETE requests are in flight at once,
+	// across all resources being destroyed. DefaultDeleteConcurrency is used
+	// when zero. A slot is held while a rate limited request backs off.
+	DeleteConcurrency int
+
+	deleteSlotsOnce sync.Once
+	deleteSlots     chan struct{}
+
+	// RetryableErrorDetails are retried like a 429 when the message or
+	// detail of an error object contains one of them, Bitbucket reports
+	// some throttling with other status codes, 4xx as well as 2xx. DefaultRetryableErrorDetails
+	// is used when nil, an empty slice turns it off.
+	RetryableErrorDetails []string
+
+	rateLimitMu sync.Mutex
+	rateLimit   RateLimitInfo
+
+	etagMu sync.Mutex
+	etags  map[string]cachedResponse
+
+	statsMu sync.Mutex
+	stats   map[string]RequestStats
+}
+
+// RequestStats sums up the requests sent to an endpoint prefix, like
+// 2.0/repositories, retries included.
+type RequestStats struct {
+	Count int
+	Total time.Duration
+}
+
+// cachedResponse is the body of a GET kept around for conditional requests.
+type cachedResponse struct {
+	etag string
+	body []byte
+}
+
+// RateLimitInfo is the rate limit state bitbucket reported on the last
+// response that carried rate limit headers.
+type RateLimitInfo struct {
+	Limit     int
+	Remaining int
+	Resource  string
+	NearLimit bool
+	Reset     time.Time
+}
+
+// LastRateLimit returns the rate limit state of the most recent response that
+// included rate limit headers.
+func (c *Client) LastRateLimit() RateLimitInfo {
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+
+	return c.rateLimit
+}
+
+// RequestStats returns the number of requests and the time spent on them per
+// endpoint prefix since the client was created.
+func (c *Client) RequestStats() map[string]RequestStats {
+	c.statsMu.Lock()
+	defer c.statsMu.Unlock()
+
+	stats := make(map[string]RequestStats, len(c.stats))
+	for prefix, s := range c.stats {
+		stats[prefix] = s
+	}
+
+	return stats
+}
+
+func (c *Client) recordRequest(endpoint string, duration time.Duration) {
+	prefix := endpointPrefix(endpoint)
+
+	c.statsMu.Lock()
+	defer c.statsMu.Unlock()
+
+	if c.stats == nil {
+		c.stats = make(map[string]RequestStats)
+	}
+
+	s := c.stats[prefix]
+	s.Count++
+	s.Total += duration
+	c.stats[prefix] = s
+}
+
+// endpointPrefix is the API version and top level collection of endpoint,
+// e.g. 2.0/repositories, which also covers the absolute next links of pages.
+func endpointPrefix(endpoint string) string {
+	endpoint = strings.SplitN(endpoint, "?", 2)[0]
+	if i := strings.Index(endpoint, "2.0/"); i >= 0 {
+		endpoint = endpoint[i:]
+	}
+
+	parts := strings.SplitN(strings.Trim(endpoint, "/"), "/", 3)
+	if len(parts) > 2 {
+		parts = parts[:2]
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// recordRateLimit keeps track of the rate limit headers of resp, responses
+// without any of them leave the last known state untouched.
+func (c *Client) recordRateLimit(resp *http.Response) {
+	header := resp.Header
+	if header.Get("X-RateLimit-Limit") == "" && header.Get("X-RateLimit-Remaining") == "" &&
+		header.Get("X-RateLimit-NearLimit") == "" {
+		return
+	}
+
+	info := RateLimitInfo{
+		Resource: header.Get("X-RateLimit-Resource"),
+	}
+	info.Limit, _ = strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	info.Remaining, _ = strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	info.NearLimit, _ = strconv.ParseBool(header.Get("X-RateLimit-NearLimit"))
+
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		info.Reset = time.Unix(reset, 0)
+	}
+
+	if info.NearLimit {
+		log.Printf("[WARN] Close to the Bitbucket rate limit for %s (%d of %d remaining), consider lowering parallelism", info.Resource, info.Remaining, info.Limit)
+	}
+
+	c.rateLimitMu.Lock()
+	c.rateLimit = info
+	c.rateLimitMu.Unlock()
+}
+
+// NewHTTPClient returns a http.Client sending requests through transport. A
+// nil transport uses a copy of the default transport, which honors the
+// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+func NewHTTPClient(transport http.RoundTripper) *http.Client {
+	if transport == nil {
+		defaultTransport := http.DefaultTransport.(*http.Transport).Clone()
+		defaultTransport.Proxy = http.ProxyFromEnvironment
+		transport = defaultTransport
+	}
+
+	return &http.Client{Transport: transport}
+}
+
+// UseClientCredentials authenticates the client as an OAuth consumer via the
+// Client Credentials Grant. Tokens are fetched on first use and refreshed
+// transparently once they expire. The token source is returned so it can be
+// shared with other API clients.
+func (c *Client) UseClientCredentials(ctx context.Context, clientID, clientSecret string) oauth2.TokenSource {
+	tokenURL := c.TokenURL
+	if tokenURL == "" {
+		tokenURL = oauth2bitbucket.Endpoint.TokenURL
+	}
+
+	config := &oauth2clientcreds.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+	}
+
+	c.OAuthTokenSource = config.TokenSource(ctx)
+
+	return c.OAuthTokenSource
 }
 
 // Do Will just call the bitbucket api but also add auth to it and some extra headers
 func (c *Client) Do(method, endpoint string, payload *bytes.Buffer, addJsonHeader bool) (*http.Response, error) {
-	absoluteendpoint := BitbucketEndpoint + endpoint
+	return c.DoWithContext(context.Background(), method, endpoint, payload, addJsonHeader)
+}
+
+// DoWithContext is Do but the request, and any wait between retries, is
+// aborted once ctx is cancelled or its deadline passes.
+func (c *Client) DoWithContext(ctx context.Context, method, endpoint string, payload *bytes.Buffer, addJsonHeader bool) (*http.Response, error) {
+	return c.do(ctx, method, endpoint, payload, addJsonHeader, nil)
+}
+
+// do sends the request with the extra headers set on every attempt.
+func (c *Client) do(ctx context.Context, method, endpoint string, payload *bytes.Buffer, addJsonHeader bool, header http.Header) (*http.Response, error) {
+	absoluteendpoint := c.absoluteURL(endpoint)
 	log.Printf("[DEBUG] Sending request to %s %s", method, absoluteendpoint)
 
-	var bodyreader io.Reader
+	// The payload is consumed by the first attempt, keep a copy around so
+	// the request can be replayed on retries.
+	var body []byte
+	if payload != nil {
+		log.Printf("[DEBUG] With payload %s", redactPayload(payload.Bytes()))
+		body = payload.Bytes()
+	}
+
+	for attempt := 0; ; attempt++ {
+		reqCtx, cancel := c.requestContext(ctx)
+
+		req, err := c.newRequest(reqCtx, method, absoluteendpoint, payload, body, addJsonHeader)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		for name, values := range header {
+			req.Header[name] = values
+		}
+
+		start := time.Now()
+		resp, err := c.httpClient().Do(req)
+		duration := time.Since(start)
+		c.recordRequest(endpoint, duration)
+		if err != nil {
+			cancel()
+			log.Printf("[DEBUG] method=%s endpoint=%s status=error dur=%s", method, endpoint, duration.Round(time.Millisecond))
+			log.Printf("[DEBUG] Request to %s %s failed: %s", method, absoluteendpoint, err)
+			return nil, fmt.Errorf("error sending request to %s %s: %w", method, endpoint, err)
+		}
+		log.Printf("[DEBUG] method=%s endpoint=%s status=%d dur=%s", method, endpoint, resp.StatusCode, duration.Round(time.Millisecond))
+		log.Printf("[DEBUG] Resp: %s %v", resp.Status, resp.Header)
+		c.recordRateLimit(resp)
+
+		if isRetryableStatus(resp.StatusCode) && attempt < c.MaxRetries {
+			wait := c.retryWait(attempt, resp)
+			log.Printf("[DEBUG] Got %d on %s %s, retrying in %s (attempt %d/%d)", resp.StatusCode, method, absoluteendpoint, wait, attempt+1, c.MaxRetries)
+			resp.Body.Close()
+			cancel()
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(wait):
+			}
+			continue
+		}
+
+		if resp.StatusCode >= 400 || resp.StatusCode < 200 {
+			apiError := Error{
+				StatusCode:  resp.StatusCode,
+				Endpoint:    endpoint,
+				ContentType: resp.Header.Get("Content-Type"),
+			}
+
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			cancel()
+			if err != nil {
+				return nil, err
+			}
+
+			// Callers still inspect the returned response, hand them a body
+			// that doesn't hold on to the connection.
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+
+			log.Printf("[DEBUG] Resp Body: %s", string(body))
+
+			apiError.RawBody = body
+			if len(bytes.TrimSpace(body)) == 0 {
+				apiError.APIError.Message = http.StatusText(resp.StatusCode)
+			} else if err := json.Unmarshal(body, &apiError); err != nil {
+				apiError.APIError.Message = truncate(string(body), maxErrorMessageLength)
+			}
+
+			if resp.StatusCode < 500 && attempt < c.MaxRetries && c.isRetryableError(apiError) {
+				wait := backoff(c.RetryWaitMin, c.RetryWaitMax, attempt)
+				log.Printf("[DEBUG] Got throttled with %d on %s %s (%s), retrying in %s (attempt %d/%d)", resp.StatusCode, method, absoluteendpoint, apiError.APIError.Message, wait, attempt+1, c.MaxRetries)
 
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				case <-time.After(wait):
+				}
+				continue
+			}
+
+			return resp, error(apiError)
+
+		}
+
+		// Bitbucket sometimes reports throttling as an error object with a
+		// successful status. Such bodies are small, other JSON bodies are
+		// buffered to check and handed back as they were.
+		if attempt < c.MaxRetries && strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			cancel()
+			if err != nil {
+				return nil, err
+			}
+
+			var throttled Error
+			if json.Unmarshal(body, &throttled) == nil && throttled.Type == "error" && c.isRetryableError(throttled) {
+				wait := backoff(c.RetryWaitMin, c.RetryWaitMax, attempt)
+				log.Printf("[DEBUG] Got throttled with %d on %s %s (%s), retrying in %s (attempt %d/%d)", resp.StatusCode, method, absoluteendpoint, throttled.APIError.Message, wait, attempt+1, c.MaxRetries)
+
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				case <-time.After(wait):
+				}
+				continue
+			}
+
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+
+			return resp, nil
+		}
+
+		// The timeout has to cover reading the body as well, so only release
+		// the context once the caller is done with it.
+		resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+
+		return resp, nil
+	}
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient == nil {
+		c.HTTPClient = NewHTTPClient(nil)
+	}
+
+	return c.HTTPClient
+}
+
+// absoluteURL joins endpoint onto the base URL, with or without trailing and
+// leading slashes on either side. Endpoints that already are absolute, like
+// the next links of paginated responses, are used as is.
+func (c *Client) absoluteURL(endpoint string) string {
+	if strings.HasPrefix(endpoint, "https://") || strings.HasPrefix(endpoint, "http://") {
+		return endpoint
+	}
+
+	baseURL := c.BaseURL
+	if baseURL == "" {
+		baseURL = BitbucketEndpoint
+	}
+
+	return strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(endpoint, "/")
+}
+
+// newRequest builds a single attempt of a request with the auth and content
+// headers set.
+func (c *Client) newRequest(ctx context.Context, method, absoluteendpoint string, payload *bytes.Buffer, body []byte, addJsonHeader bool) (*http.Request, error) {
+	var bodyreader io.Reader
 	if payload != nil {
-		log.Printf("[DEBUG] With payload %s", payload.String())
-		bodyreader = payload
+		bodyreader = bytes.NewReader(body)
 	}
 
-	req, err := http.NewRequest(method, absoluteendpoint, bodyreader)
+	req, err := http.NewRequestWithContext(ctx, method, absoluteendpoint, bodyreader)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +484,10 @@ func (c *Client) Do(method, endpoint string, payload *bytes.Buffer, addJsonHeade
 		token.SetAuthHeader(req)
 	}
 
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
 	if payload != nil && addJsonHeader {
 		// Can cause bad request when putting default reviews if set.
 		req.Header.Add("Content-Type", "application/json")
@@ -84,30 +495,301 @@ func (c *Client) Do(method, endpoint string, payload *bytes.Buffer, addJsonHeade
 
 	req.Close = true
 
-	resp, err := c.HTTPClient.Do(req)
-	log.Printf("[DEBUG] Resp: %v Err: %v", resp, err)
-	if resp.StatusCode >= 400 || resp.StatusCode < 200 {
-		apiError := Error{
-			StatusCode: resp.StatusCode,
-			Endpoint:   endpoint,
+	return req, nil
+}
+
+// requestContext derives the context of a single attempt, bounded by Timeout
+// when one is configured.
+func (c *Client) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.Timeout > 0 {
+		return context.WithTimeout(ctx, c.Timeout)
+	}
+
+	return ctx, func() {}
+}
+
+// cancelOnClose releases the context of a request once its body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// sensitiveKeys are JSON fields whose values are masked before a payload is
+// logged, as they can carry private keys, secrets and tokens.
+var sensitiveKeys = map[string]bool{
+	"password":    true,
+	"secret":      true,
+	"key":         true,
+	"private_key": true,
+	"token":       true,
+	"value":       true,
+}
+
+// redactPayload renders a request payload for logging with the values of
+// sensitive fields replaced by ***. Payloads that aren't JSON are only logged
+// by size since they can't be inspected.
+func redactPayload(payload []byte) string {
+	var decoded interface{}
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		return fmt.Sprintf("<%d bytes of non-JSON payload>", len(payload))
+	}
+
+	redacted, err := json.Marshal(redactValue(decoded))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes of payload>", len(payload))
+	}
+
+	return string(redacted)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for field, nested := range v {
+			if sensitiveKeys[strings.ToLower(field)] {
+				v[field] = "***"
+				continue
+			}
+			v[field] = redactValue(nested)
+		}
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = redactValue(nested)
 		}
+	}
 
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return nil, err
+	return value
+}
+
+// DefaultRetryableErrorDetails are the parts of error messages Bitbucket uses
+// for throttling and transient capacity issues.
+var DefaultRetryableErrorDetails = []string{
+	"rate limit",
+	"throttl",
+	"too many requests",
+	"try again later",
+	"temporarily unavailable",
+}
+
+// isRetryableError reports whether the message or detail of apiError says
+// the request was throttled rather than rejected.
+func (c *Client) isRetryableError(apiError Error) bool {
+	details := c.RetryableErrorDetails
+	if details == nil {
+		details = DefaultRetryableErrorDetails
+	}
+
+	text := strings.ToLower(apiError.APIError.Message + " " + apiError.APIError.Detail)
+	for _, detail := range details {
+		if detail != "" && strings.Contains(text, strings.ToLower(detail)) {
+			return true
 		}
+	}
 
-		log.Printf("[DEBUG] Resp Body: %s", string(body))
+	return false
+}
 
-		err = json.Unmarshal(body, &apiError)
-		if err != nil {
-			apiError.APIError.Message = string(body)
+// isRetryableStatus reports whether a request that got the status code is
+// worth retrying, any other error status fails fast.
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// retryWait returns how long to wait before the next attempt. Rate limited
+// responses carrying a Retry-After header are honored, everything else uses
+// exponential backoff with jitter.
+func (c *Client) retryWait(attempt int, resp *http.Response) time.Duration {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+			return parseRetryAfter(retryAfter)
 		}
+	}
+
+	return backoff(c.RetryWaitMin, c.RetryWaitMax, attempt)
+}
+
+// backoff doubles min for every attempt, capped at max, and picks a random
+// duration in the upper half so concurrent clients don't retry in lockstep.
+func backoff(min, max time.Duration, attempt int) time.Duration {
+	if min <= 0 {
+		return 0
+	}
 
-		return resp, error(apiError)
+	wait := min
+	for i := 0; i < attempt && (max <= 0 || wait < max); i++ {
+		wait *= 2
+	}
 
+	if max > 0 && wait > max {
+		wait = max
 	}
-	return resp, err
+
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter converts the value of a Retry-After header, either
+// delta-seconds or a HTTP-date, into a duration to wait before retrying.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait
+		}
+	}
+
+	return 0
+}
+
+// DoAndDecode calls Do with a JSON payload and decodes the JSON response into
+// out. The response body is always closed. Responses without a body, like a
+// 204 No Content, succeed without touching out.
+func (c *Client) DoAndDecode(method, endpoint string, payload *bytes.Buffer, out interface{}) error {
+	resp, err := c.Do(method, endpoint, payload, true)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if out == nil || resp.StatusCode == http.StatusNoContent || len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+// Paginated is the envelope the 2.0 API wraps collections in.
+type Paginated struct {
+	Values  []json.RawMessage `json:"values"`
+	Page    int               `json:"page,omitempty"`
+	Pagelen int               `json:"pagelen,omitempty"`
+	Size    int               `json:"size,omitempty"`
+	Next    string            `json:"next,omitempty"`
+}
+
+// GetPaged calls each for every value of a paginated 2.0 collection,
+// following the next links until the last page. Iteration stops at the first
+// error returned by the API or by each.
+func (c *Client) GetPaged(endpoint string, each func(json.RawMessage) error) error {
+	maxPages := c.MaxPages
+	if maxPages <= 0 {
+		maxPages = DefaultMaxPages
+	}
+
+	for pages := 0; endpoint != ""; pages++ {
+		if pages == maxPages {
+			return fmt.Errorf("giving up on %s after %d pages", endpoint, maxPages)
+		}
+
+		var page Paginated
+		if err := c.DoAndDecode(http.MethodGet, endpoint, nil, &page); err != nil {
+			return err
+		}
+
+		for _, value := range page.Values {
+			if err := each(value); err != nil {
+				return err
+			}
+		}
+
+		endpoint = page.Next
+	}
+
+	return nil
+}
+
+// GetPagedWithQuery is GetPaged with params, like the q filter or sort field
+// of list endpoints, encoded as the query string of the first page.
+func (c *Client) GetPagedWithQuery(path string, params url.Values, each func(json.RawMessage) error) error {
+	return c.GetPaged(withQuery(path, params), each)
+}
+
+// GetAllValues collects the values of every page of a paginated 2.0
+// collection.
+func (c *Client) GetAllValues(endpoint string) ([]json.RawMessage, error) {
+	var values []json.RawMessage
+
+	err := c.GetPaged(endpoint, func(value json.RawMessage) error {
+		values = append(values, value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// DoConditional GETs endpoint sending the ETag of the previous response, if
+// any, as If-None-Match. When bitbucket answers 304 Not Modified the cached
+// body is returned with notModified set, so callers can skip parsing it
+// again. The cache lives in memory for the lifetime of the client.
+func (c *Client) DoConditional(endpoint string) (body []byte, notModified bool, err error) {
+	c.etagMu.Lock()
+	cached, ok := c.etags[endpoint]
+	c.etagMu.Unlock()
+
+	header := http.Header{}
+	if ok {
+		header.Set("If-None-Match", cached.etag)
+	}
+
+	resp, err := c.do(context.Background(), http.MethodGet, endpoint, nil, true, header)
+	if err != nil {
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+
+	if ok && resp.StatusCode == http.StatusNotModified {
+		log.Printf("[DEBUG] %s not modified since the last request", endpoint)
+		return cached.body, true, nil
+	}
+
+	body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		c.etagMu.Lock()
+		if c.etags == nil {
+			c.etags = make(map[string]cachedResponse)
+		}
+		c.etags[endpoint] = cachedResponse{etag: etag, body: body}
+		c.etagMu.Unlock()
+	}
+
+	return body, false, nil
 }
 
 // Get is just a helper method to do but with a GET verb
@@ -115,6 +797,27 @@ func (c *Client) Get(endpoint string) (*http.Response, error) {
 	return c.Do("GET", endpoint, nil, true)
 }
 
+// GetWithQuery is Get with params, like the q filter or sort field of list
+// endpoints, encoded as the query string of path.
+func (c *Client) GetWithQuery(path string, params url.Values) (*http.Response, error) {
+	return c.Get(withQuery(path, params))
+}
+
+// withQuery appends params to path as an encoded query string, keeping any
+// query path already has. Empty params leave path alone.
+func withQuery(path string, params url.Values) string {
+	if len(params) == 0 {
+		return path
+	}
+
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+
+	return path + separator + params.Encode()
+}
+
 // Post is just a helper method to do but with a POST verb
 func (c *Client) Post(endpoint string, jsonpayload *bytes.Buffer) (*http.Response, error) {
 	return c.Do("POST", endpoint, jsonpayload, true)
@@ -125,11 +828,27 @@ func (c *Client) PostNonJson(endpoint string, jsonpayload *bytes.Buffer) (*http.
 	return c.Do("POST", endpoint, jsonpayload, false)
 }
 
+// DoMultipart sends a multipart payload, the Content-Type carries the
+// boundary separating its parts.
+func (c *Client) DoMultipart(method, endpoint, contentType string, payload *bytes.Buffer) (*http.Response, error) {
+	return c.do(context.Background(), method, endpoint, payload, false, http.Header{"Content-Type": {contentType}})
+}
+
+// PostMultipart is just a helper method to DoMultipart but with a POST verb
+func (c *Client) PostMultipart(endpoint, contentType string, payload *bytes.Buffer) (*http.Response, error) {
+	return c.DoMultipart("POST", endpoint, contentType, payload)
+}
+
 // Put is just a helper method to do but with a PUT verb
 func (c *Client) Put(endpoint string, jsonpayload *bytes.Buffer) (*http.Response, error) {
 	return c.Do("PUT", endpoint, jsonpayload, true)
 }
 
+// Patch is just a helper method to do but with a PATCH verb
+func (c *Client) Patch(endpoint string, jsonpayload *bytes.Buffer) (*http.Response, error) {
+	return c.Do("PATCH", endpoint, jsonpayload, true)
+}
+
 // PutOnly is just a helper method to do but with a PUT verb and a nil body
 func (c *Client) PutOnly(endpoint string) (*http.Response, error) {
 	return c.Do("PUT", endpoint, nil, true)
@@ -137,5 +856,44 @@ func (c *Client) PutOnly(endpoint string) (*http.Response, error) {
 
 // Delete is just a helper to Do but with a DELETE verb
 func (c *Client) Delete(endpoint string) (*http.Response, error) {
+	c.deleteSlotsOnce.Do(func() {
+		concurrency := c.DeleteConcurrency
+		if concurrency <= 0 {
+			concurrency = DefaultDeleteConcurrency
+		}
+		c.deleteSlots = make(chan struct{}, concurrency)
+	})
+
+	c.deleteSlots <- struct{}{}
+	defer func() { <-c.deleteSlots }()
+
 	return c.Do("DELETE", endpoint, nil, true)
 }
+
+// DeleteAll deletes all endpoints in parallel, bounded by DeleteConcurrency.
+// Endpoints that are already gone are skipped, the first other error is
+// returned once all deletes are done.
+func (c *Client) DeleteAll(endpoints []string) error {
+	errs := make([]error, len(endpoints))
+
+	var wg sync.WaitGroup
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func(i int, endpoint string) {
+			defer wg.Done()
+
+			if _, err := c.Delete(endpoint); err != nil && !IsNotFound(err) {
+				errs[i] = err
+			}
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
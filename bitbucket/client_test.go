@@ -0,0 +1,1240 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// errorTransport fails every request before a response is received, like a
+// DNS failure or a refused connection would.
+type errorTransport struct {
+	err error
+}
+
+func (rt errorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, rt.err
+}
+
+// recordingTransport answers every request itself and remembers what it was
+// asked for.
+type recordingTransport struct {
+	requests []*http.Request
+}
+
+func (rt *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.requests = append(rt.requests, req)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(`{}`)),
+		Request:    req,
+	}, nil
+}
+
+func testClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+}
+
+func TestClientDo_retriesRateLimited(t *testing.T) {
+	var attempts int
+	var payloads []string
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		payloads = append(payloads, string(body))
+
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	client.MaxRetries = DefaultMaxRetries
+
+	_, err := client.Post("2.0/test", bytes.NewBufferString(`{"name":"test"}`))
+	if err != nil {
+		t.Fatalf("expected request to succeed after retries, got: %s", err)
+	}
+
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+
+	for i, payload := range payloads {
+		if payload != `{"name":"test"}` {
+			t.Errorf("attempt %d sent payload %q, expected it to be replayed", i+1, payload)
+		}
+	}
+}
+
+func TestClientDeleteAll_boundsConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	var deleted []string
+	limited := map[string]bool{}
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		// Repositories ending in 0 are rate limited once.
+		rateLimited := strings.HasSuffix(r.URL.Path, "0") && !limited[r.URL.Path]
+		limited[r.URL.Path] = true
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		if !rateLimited {
+			deleted = append(deleted, r.URL.Path)
+		}
+		mu.Unlock()
+
+		switch {
+		case rateLimited:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case r.URL.Path == "/2.0/repositories/example/repo-5":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	client.MaxRetries = DefaultMaxRetries
+	client.DeleteConcurrency = 3
+
+	var endpoints []string
+	for i := 0; i < 21; i++ {
+		endpoints = append(endpoints, fmt.Sprintf("2.0/repositories/example/repo-%d", i))
+	}
+
+	if err := client.DeleteAll(endpoints); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if maxInFlight > 3 {
+		t.Errorf("expected at most 3 requests in flight, got %d", maxInFlight)
+	}
+
+	if maxInFlight < 2 {
+		t.Errorf("expected deletes to run in parallel, got %d in flight at most", maxInFlight)
+	}
+
+	if len(deleted) != len(endpoints) {
+		t.Errorf("expected %d deletes after retries, got %d", len(endpoints), len(deleted))
+	}
+}
+
+func TestClientDelete_limited(t *testing.T) {
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	})
+	client.DeleteConcurrency = 1
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, err := client.Delete(fmt.Sprintf("2.0/repositories/example/repo-%d", i)); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if maxInFlight != 1 {
+		t.Errorf("expected single deletes to share DeleteConcurrency, got %d in flight at most", maxInFlight)
+	}
+}
+
+func TestClientDeleteAll_error(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/2.0/repositories/example/locked" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.DeleteAll([]string{"2.0/repositories/example/repo", "2.0/repositories/example/locked"})
+
+	var apiError Error
+	if !errors.As(err, &apiError) || apiError.StatusCode != http.StatusForbidden {
+		t.Errorf("expected the failed delete to be reported, got %v", err)
+	}
+}
+
+func TestClientDo_rateLimitRetriesExhausted(t *testing.T) {
+	var attempts int
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	client.MaxRetries = 2
+
+	_, err := client.Get("2.0/test")
+	if err == nil {
+		t.Fatal("expected an error once retries are exhausted")
+	}
+
+	apiErr, ok := err.(Error)
+	if !ok {
+		t.Fatalf("expected an Error, got %T", err)
+	}
+
+	if apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected status %d, got %d", http.StatusTooManyRequests, apiErr.StatusCode)
+	}
+
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestClientDo_retriesServerErrors(t *testing.T) {
+	var attempts int
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		switch attempts {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+	client.MaxRetries = DefaultMaxRetries
+	client.RetryWaitMin = time.Millisecond
+	client.RetryWaitMax = 5 * time.Millisecond
+
+	_, err := client.Put("2.0/test", bytes.NewBufferString(`{}`))
+	if err != nil {
+		t.Fatalf("expected request to succeed after retries, got: %s", err)
+	}
+
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestClientDo_clientErrorsFailFast(t *testing.T) {
+	var attempts int
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	client.MaxRetries = DefaultMaxRetries
+	client.RetryWaitMin = time.Millisecond
+
+	_, err := client.Get("2.0/test")
+	if err == nil {
+		t.Fatal("expected a 400 to return an error")
+	}
+
+	if attempts != 1 {
+		t.Errorf("expected a single attempt, got %d", attempts)
+	}
+}
+
+func TestClientDo_transportError(t *testing.T) {
+	transportErr := errors.New("connection refused")
+	client := &Client{
+		HTTPClient: &http.Client{Transport: errorTransport{err: transportErr}},
+		MaxRetries: DefaultMaxRetries,
+	}
+
+	resp, err := client.Get("2.0/test")
+	if err == nil {
+		t.Fatal("expected the transport error to be returned")
+	}
+
+	if resp != nil {
+		t.Errorf("expected no response, got %#v", resp)
+	}
+
+	if !errors.Is(err, transportErr) {
+		t.Errorf("expected error to wrap %q, got: %s", transportErr, err)
+	}
+}
+
+func TestClientDoAndDecode(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected a POST, got %s", r.Method)
+		}
+		w.Write([]byte(`{"uuid":"{abc}","url":"https://example.com"}`))
+	})
+
+	var hook Hook
+	err := client.DoAndDecode(http.MethodPost, "2.0/test", bytes.NewBufferString(`{}`), &hook)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hook.UUID != "{abc}" || hook.URL != "https://example.com" {
+		t.Errorf("unexpected decoded value %#v", hook)
+	}
+}
+
+func TestClientDoAndDecode_emptyBodies(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2.0/no-content":
+			w.WriteHeader(http.StatusNoContent)
+		case "/2.0/empty":
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+
+	for _, endpoint := range []string{"2.0/no-content", "2.0/empty"} {
+		hook := Hook{UUID: "unchanged"}
+		if err := client.DoAndDecode(http.MethodPut, endpoint, nil, &hook); err != nil {
+			t.Errorf("%s: expected an empty body to succeed, got: %s", endpoint, err)
+		}
+
+		if hook.UUID != "unchanged" {
+			t.Errorf("%s: expected the output to be left alone, got %#v", endpoint, hook)
+		}
+	}
+}
+
+func TestClientDo_emptyErrorBody(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := client.Get("2.0/test")
+	apiErr, ok := err.(Error)
+	if !ok {
+		t.Fatalf("expected an Error, got %T", err)
+	}
+
+	if apiErr.APIError.Message != "Forbidden" {
+		t.Errorf("expected the status text as message, got %q", apiErr.APIError.Message)
+	}
+}
+
+func TestClientDo_errorDetail(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"type":"error","error":{"message":"Bad request","detail":"name: This field is required."}}`))
+	})
+
+	_, err := client.Get("2.0/test")
+	apiErr, ok := err.(Error)
+	if !ok {
+		t.Fatalf("expected an Error, got %T", err)
+	}
+
+	if apiErr.APIError.Message != "Bad request" || apiErr.APIError.Detail != "name: This field is required." {
+		t.Errorf("unexpected message %q and detail %q", apiErr.APIError.Message, apiErr.APIError.Detail)
+	}
+
+	if expected := "API Error: 400 2.0/test Bad request: name: This field is required."; apiErr.Error() != expected {
+		t.Errorf("expected error string %q, got %q", expected, apiErr.Error())
+	}
+}
+
+func TestClientDo_retriesThrottledErrorDetail(t *testing.T) {
+	var attempts int
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"type":"error","error":{"message":"Bad request","detail":"Rate limit for this resource has been exceeded"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	client.MaxRetries = DefaultMaxRetries
+
+	if _, err := client.Get("2.0/test"); err != nil {
+		t.Fatalf("expected request to succeed after retries, got: %s", err)
+	}
+
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestClientDo_retriesThrottledSuccessStatus(t *testing.T) {
+	var attempts int
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		if attempts < 2 {
+			w.Write([]byte(`{"type":"error","error":{"message":"Service busy","detail":"Please try again later"}}`))
+			return
+		}
+		w.Write([]byte(`{"uuid":"{hook}"}`))
+	})
+	client.MaxRetries = DefaultMaxRetries
+
+	var hook Hook
+	if err := client.DoAndDecode(http.MethodGet, "2.0/test", nil, &hook); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+
+	if hook.UUID != "{hook}" {
+		t.Errorf("expected the body of the retried response, got %#v", hook)
+	}
+}
+
+func TestClientDo_doesNotRetryOtherErrorDetails(t *testing.T) {
+	var attempts int
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"type":"error","error":{"message":"Bad request","detail":"Capacity is temporarily unavailable"}}`))
+	})
+	client.MaxRetries = DefaultMaxRetries
+	client.RetryableErrorDetails = []string{}
+
+	if _, err := client.Get("2.0/test"); err == nil {
+		t.Fatal("expected the request to fail")
+	}
+
+	if attempts != 1 {
+		t.Errorf("expected no retries with the detail matching turned off, got %d attempts", attempts)
+	}
+}
+
+func TestClientDo_htmlErrorBody(t *testing.T) {
+	page := "<html><body>" + strings.Repeat("Bad Gateway ", 1000) + "</body></html>"
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte(page))
+	})
+
+	_, err := client.Get("2.0/test")
+	apiErr, ok := err.(Error)
+	if !ok {
+		t.Fatalf("expected an Error, got %T", err)
+	}
+
+	if apiErr.ContentType != "text/html; charset=utf-8" {
+		t.Errorf("unexpected content type %q", apiErr.ContentType)
+	}
+
+	if string(apiErr.RawBody) != page {
+		t.Error("expected the full body to be kept in RawBody")
+	}
+
+	if len(apiErr.APIError.Message) > maxErrorMessageLength+len("... (truncated)") {
+		t.Errorf("expected the message to be capped, got %d characters", len(apiErr.APIError.Message))
+	}
+
+	if !strings.HasPrefix(apiErr.Error(), "API Error: 502 2.0/test <html>") {
+		t.Errorf("unexpected error string %q", apiErr.Error()[:50])
+	}
+}
+
+func TestClientDo_errorBodyStillReadable(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"type":"error","error":{"message":"not found"}}`))
+	})
+
+	resp, err := client.Get("2.0/test")
+	if err == nil {
+		t.Fatal("expected an error for a 404")
+	}
+
+	body, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		t.Fatalf("expected the error body to remain readable, got: %s", readErr)
+	}
+
+	if len(body) == 0 {
+		t.Error("expected the error body to be preserved")
+	}
+}
+
+func TestClientDoWithContext_cancelled(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	_, err := client.DoWithContext(ctx, http.MethodGet, "2.0/test", nil, true)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+}
+
+func TestClientDoWithContext_cancelledWhileWaitingToRetry(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	client.MaxRetries = DefaultMaxRetries
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.DoWithContext(ctx, http.MethodGet, "2.0/test", nil, true)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got: %v", err)
+	}
+}
+
+func TestClientDo_timeout(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+	client.Timeout = 50 * time.Millisecond
+
+	start := time.Now()
+	_, err := client.Get("2.0/test")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected the request to be aborted after the timeout, took %s", elapsed)
+	}
+}
+
+func TestClientDo_timeoutCoversBody(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"values":`))
+		w.(http.Flusher).Flush()
+
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+	client.Timeout = 50 * time.Millisecond
+
+	resp, err := client.Get("2.0/test")
+	if err != nil {
+		t.Fatalf("expected headers to arrive before the timeout, got: %s", err)
+	}
+	defer resp.Body.Close()
+
+	_, err = io.ReadAll(resp.Body)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected reading the body to hit the deadline, got: %v", err)
+	}
+}
+
+func TestClientAbsoluteURL(t *testing.T) {
+	cases := []struct {
+		baseURL  string
+		endpoint string
+		expected string
+	}{
+		{"", "2.0/repositories", "https://api.bitbucket.org/2.0/repositories"},
+		{"https://bitbucket.example.com", "2.0/repositories", "https://bitbucket.example.com/2.0/repositories"},
+		{"https://bitbucket.example.com/", "2.0/repositories", "https://bitbucket.example.com/2.0/repositories"},
+		{"https://bitbucket.example.com/", "/2.0/repositories", "https://bitbucket.example.com/2.0/repositories"},
+		{"https://example.com/bitbucket", "rest/api/1.0/projects", "https://example.com/bitbucket/rest/api/1.0/projects"},
+	}
+
+	for _, tc := range cases {
+		client := &Client{BaseURL: tc.baseURL}
+		if actual := client.absoluteURL(tc.endpoint); actual != tc.expected {
+			t.Errorf("BaseURL %q, endpoint %q: expected %q, got %q", tc.baseURL, tc.endpoint, tc.expected, actual)
+		}
+	}
+}
+
+func TestClientDo_baseURL(t *testing.T) {
+	var path string
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+	})
+	client.BaseURL = client.BaseURL + "/bitbucket/"
+
+	if _, err := client.Get("2.0/repositories/workspace"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if path != "/bitbucket/2.0/repositories/workspace" {
+		t.Errorf("unexpected request path %q", path)
+	}
+}
+
+func TestClientDo_userAgent(t *testing.T) {
+	var agent string
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		agent = r.Header.Get("User-Agent")
+	})
+	client.UserAgent = "terraform-provider-bitbucket/test"
+
+	if _, err := client.Get("2.0/test"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if agent != "terraform-provider-bitbucket/test" {
+		t.Errorf("unexpected User-Agent %q", agent)
+	}
+}
+
+func testPagedHandler(t *testing.T, pages ...string) http.HandlerFunc {
+	t.Helper()
+
+	var serverURL string
+	return func(w http.ResponseWriter, r *http.Request) {
+		if serverURL == "" {
+			serverURL = "http://" + r.Host
+		}
+
+		page := 1
+		if p := r.URL.Query().Get("page"); p != "" {
+			fmt.Sscanf(p, "%d", &page)
+		}
+
+		if page < 1 || page > len(pages) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		next := ""
+		if page < len(pages) {
+			next = fmt.Sprintf(`,"next":"%s%s?page=%d"`, serverURL, r.URL.Path, page+1)
+		}
+
+		fmt.Fprintf(w, `{"page":%d,"pagelen":2,"values":%s%s}`, page, pages[page-1], next)
+	}
+}
+
+func TestClientGetWithQuery(t *testing.T) {
+	var rawQuery string
+	var q string
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2.0/repositories/example" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		rawQuery = r.URL.RawQuery
+		q = r.URL.Query().Get("q")
+	})
+
+	filter := `name ~ "tf-test & co" AND project.key = "OPS"`
+	if _, err := client.GetWithQuery("2.0/repositories/example", url.Values{"q": {filter}, "sort": {"-updated_on"}}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "q=name+~+%22tf-test+%26+co%22+AND+project.key+%3D+%22OPS%22&sort=-updated_on"; rawQuery != expected {
+		t.Errorf("expected query %q, got %q", expected, rawQuery)
+	}
+
+	if q != filter {
+		t.Errorf("expected the filter to arrive as %q, got %q", filter, q)
+	}
+}
+
+func TestClientGetPagedWithQuery(t *testing.T) {
+	var q string
+	paged := testPagedHandler(t, `[{"slug":"a"}]`, `[{"slug":"b"}]`)
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "" {
+			q = r.URL.Query().Get("q")
+		}
+		paged(w, r)
+	})
+
+	var slugs []string
+	err := client.GetPagedWithQuery("2.0/repositories/example", url.Values{"q": {`name ~ "a&b"`}}, func(value json.RawMessage) error {
+		var repo struct {
+			Slug string `json:"slug"`
+		}
+		if err := json.Unmarshal(value, &repo); err != nil {
+			return err
+		}
+		slugs = append(slugs, repo.Slug)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if q != `name ~ "a&b"` {
+		t.Errorf("expected the filter to be encoded, got %q", q)
+	}
+
+	if strings.Join(slugs, ",") != "a,b" {
+		t.Errorf("expected both pages to be followed, got %v", slugs)
+	}
+}
+
+func TestWithQuery(t *testing.T) {
+	cases := []struct {
+		path     string
+		params   url.Values
+		expected string
+	}{
+		{"2.0/user", nil, "2.0/user"},
+		{"2.0/repositories/example", url.Values{"q": {`slug="repo"`}}, "2.0/repositories/example?q=slug%3D%22repo%22"},
+		{"2.0/repositories/example?role=admin", url.Values{"sort": {"name"}}, "2.0/repositories/example?role=admin&sort=name"},
+	}
+
+	for _, tc := range cases {
+		if got := withQuery(tc.path, tc.params); got != tc.expected {
+			t.Errorf("expected %q, got %q", tc.expected, got)
+		}
+	}
+}
+
+func TestClientGetPaged(t *testing.T) {
+	client := testClient(t, testPagedHandler(t, `[{"slug":"a"},{"slug":"b"}]`, `[{"slug":"c"}]`))
+
+	var slugs []string
+	err := client.GetPaged("2.0/test", func(raw json.RawMessage) error {
+		var value struct {
+			Slug string `json:"slug"`
+		}
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+		slugs = append(slugs, value.Slug)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if fmt.Sprint(slugs) != "[a b c]" {
+		t.Errorf("expected values of both pages, got %v", slugs)
+	}
+}
+
+func TestClientGetPaged_callbackError(t *testing.T) {
+	client := testClient(t, testPagedHandler(t, `[{"slug":"a"}]`, `[{"slug":"b"}]`))
+
+	stop := errors.New("stop")
+	var calls int
+	err := client.GetPaged("2.0/test", func(raw json.RawMessage) error {
+		calls++
+		return stop
+	})
+
+	if !errors.Is(err, stop) {
+		t.Fatalf("expected the callback error, got: %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("expected iteration to stop after the first value, got %d calls", calls)
+	}
+}
+
+func TestClientPostMultipart(t *testing.T) {
+	var fields map[string]string
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("expected a multipart payload: %s", err)
+			return
+		}
+
+		fields = map[string]string{}
+		for k, v := range r.MultipartForm.Value {
+			fields[k] = v[0]
+		}
+	})
+
+	var payload bytes.Buffer
+	writer := multipart.NewWriter(&payload)
+	writer.WriteField("message", "test")
+	writer.WriteField("README.md", "# test")
+	writer.Close()
+
+	if _, err := client.PostMultipart("2.0/test", writer.FormDataContentType(), &payload); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if fields["message"] != "test" || fields["README.md"] != "# test" {
+		t.Errorf("unexpected fields %v", fields)
+	}
+}
+
+func TestClientPatch(t *testing.T) {
+	var method, contentType, body string
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		contentType = r.Header.Get("Content-Type")
+		raw, _ := io.ReadAll(r.Body)
+		body = string(raw)
+	})
+
+	if _, err := client.Patch("2.0/test", bytes.NewBufferString(`{"description":"test"}`)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if method != http.MethodPatch {
+		t.Errorf("expected a PATCH, got %s", method)
+	}
+
+	if contentType != "application/json" {
+		t.Errorf("expected a JSON content type, got %q", contentType)
+	}
+
+	if body != `{"description":"test"}` {
+		t.Errorf("unexpected payload %q", body)
+	}
+}
+
+func TestClientUseClientCredentials(t *testing.T) {
+	var tokenRequests int
+
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("unable to parse token request: %s", err)
+		}
+
+		if grant := r.PostForm.Get("grant_type"); grant != "client_credentials" {
+			t.Errorf("expected the client_credentials grant, got %q", grant)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"test-token","token_type":"bearer","expires_in":3600}`))
+	}))
+	defer tokenServer.Close()
+
+	var authorization []string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		authorization = append(authorization, r.Header.Get("Authorization"))
+	})
+	client.TokenURL = tokenServer.URL
+
+	client.UseClientCredentials(context.Background(), "key", "secret")
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.Get("2.0/test"); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	for _, header := range authorization {
+		if header != "Bearer test-token" {
+			t.Errorf("expected the bearer token to be attached, got %q", header)
+		}
+	}
+
+	if tokenRequests != 1 {
+		t.Errorf("expected the token to be reused until it expires, got %d token requests", tokenRequests)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2.0/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/2.0/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+		}
+	})
+
+	_, err := client.Get("2.0/missing")
+	if !IsNotFound(err) {
+		t.Errorf("expected a 404 to be reported as not found, got: %v", err)
+	}
+
+	if apiErr, ok := err.(Error); !ok || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected the Error to keep its status code, got: %#v", err)
+	}
+
+	if !IsNotFound(fmt.Errorf("wrapped: %w", err)) {
+		t.Error("expected a wrapped 404 to be reported as not found")
+	}
+
+	_, err = client.Get("2.0/forbidden")
+	if IsNotFound(err) {
+		t.Errorf("expected a 403 not to be reported as not found")
+	}
+
+	if IsNotFound(nil) || IsNotFound(errors.New("404")) {
+		t.Error("expected only API errors to be reported as not found")
+	}
+}
+
+func TestClientLastRateLimit(t *testing.T) {
+	var calls int
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("X-RateLimit-Limit", "1000")
+			w.Header().Set("X-RateLimit-Remaining", "42")
+			w.Header().Set("X-RateLimit-Resource", "api-repository")
+			w.Header().Set("X-RateLimit-NearLimit", "true")
+			w.Header().Set("X-RateLimit-Reset", "1700000000")
+		}
+	})
+
+	if info := client.LastRateLimit(); info != (RateLimitInfo{}) {
+		t.Errorf("expected no rate limit state before any request, got %#v", info)
+	}
+
+	if _, err := client.Get("2.0/test"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := RateLimitInfo{
+		Limit:     1000,
+		Remaining: 42,
+		Resource:  "api-repository",
+		NearLimit: true,
+		Reset:     time.Unix(1700000000, 0),
+	}
+
+	if info := client.LastRateLimit(); info != expected {
+		t.Errorf("expected %#v, got %#v", expected, info)
+	}
+
+	if _, err := client.Get("2.0/test"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if info := client.LastRateLimit(); info != expected {
+		t.Errorf("expected a response without headers to keep the last state, got %#v", info)
+	}
+}
+
+func TestClientDo_redactsPayloadLogs(t *testing.T) {
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {})
+	password := "hunter2"
+	username := "user"
+	client.Username = &username
+	client.Password = &password
+
+	payload := `{"url":"https://example.com","secret":"s3cr3t","nested":[{"token":"t0k3n","name":"visible"}]}`
+	if _, err := client.Post("2.0/test", bytes.NewBufferString(payload)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	output := logs.String()
+	for _, leaked := range []string{"s3cr3t", "t0k3n", "hunter2"} {
+		if strings.Contains(output, leaked) {
+			t.Errorf("expected %q to be redacted from the logs:\n%s", leaked, output)
+		}
+	}
+
+	for _, kept := range []string{"https://example.com", "visible", `"secret":"***"`} {
+		if !strings.Contains(output, kept) {
+			t.Errorf("expected %q in the logs:\n%s", kept, output)
+		}
+	}
+}
+
+func TestClientDo_logsDuration(t *testing.T) {
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Put("2.0/repositories/example/repo", bytes.NewBufferString(`{}`)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	line := regexp.MustCompile(`method=PUT endpoint=2\.0/repositories/example/repo status=204 dur=(\S+)`).FindStringSubmatch(logs.String())
+	if line == nil {
+		t.Fatalf("expected the request to be logged with its duration:\n%s", logs.String())
+	}
+
+	if duration, err := time.ParseDuration(line[1]); err != nil || duration < 20*time.Millisecond {
+		t.Errorf("expected a duration of at least 20ms, got %q", line[1])
+	}
+}
+
+func TestClientRequestStats(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {})
+
+	for _, endpoint := range []string{"2.0/repositories/example/repo", "2.0/repositories/example/repo/refs?q=name", "2.0/user"} {
+		if _, err := client.Get(endpoint); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	stats := client.RequestStats()
+	if len(stats) != 2 || stats["2.0/repositories"].Count != 2 || stats["2.0/user"].Count != 1 {
+		t.Errorf("unexpected stats %#v", stats)
+	}
+
+	if stats["2.0/repositories"].Total <= 0 {
+		t.Error("expected the time spent to be summed up")
+	}
+}
+
+func TestEndpointPrefix(t *testing.T) {
+	cases := map[string]string{
+		"2.0/user":                              "2.0/user",
+		"/2.0/workspaces/example/members":       "2.0/workspaces",
+		"2.0/repositories/example?q=name":       "2.0/repositories",
+		"https://api.bitbucket.org/2.0/teams/x": "2.0/teams",
+	}
+
+	for endpoint, expected := range cases {
+		if prefix := endpointPrefix(endpoint); prefix != expected {
+			t.Errorf("expected prefix %q for %q, got %q", expected, endpoint, prefix)
+		}
+	}
+}
+
+func TestRedactPayload(t *testing.T) {
+	if redacted := redactPayload([]byte(`{"key":"ssh-rsa AAAA","label":"deploy"}`)); redacted != `{"key":"***","label":"deploy"}` {
+		t.Errorf("unexpected redaction %s", redacted)
+	}
+
+	if redacted := redactPayload([]byte(`name=group`)); strings.Contains(redacted, "group") {
+		t.Errorf("expected non-JSON payloads not to be logged verbatim, got %s", redacted)
+	}
+}
+
+func TestNewHTTPClient_customTransport(t *testing.T) {
+	transport := &recordingTransport{}
+	client := &Client{HTTPClient: NewHTTPClient(transport)}
+
+	if _, err := client.Get("2.0/repositories/workspace"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(transport.requests) != 1 {
+		t.Fatalf("expected the request to go through the custom transport, got %d requests", len(transport.requests))
+	}
+
+	if actual := transport.requests[0].URL.String(); actual != "https://api.bitbucket.org/2.0/repositories/workspace" {
+		t.Errorf("unexpected request URL %s", actual)
+	}
+}
+
+func TestNewHTTPClient_defaultTransportUsesProxy(t *testing.T) {
+	transport, ok := NewHTTPClient(nil).Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("expected a *http.Transport, got %T", NewHTTPClient(nil).Transport)
+	}
+
+	// http.ProxyFromEnvironment reads the environment only once per process,
+	// so checking that the hook is set is as far as this can go.
+	if transport.Proxy == nil {
+		t.Fatal("expected the default transport to honor the proxy environment variables")
+	}
+
+	if transport == http.DefaultTransport {
+		t.Error("expected the default transport to be copied rather than shared")
+	}
+}
+
+func TestClientGetAllValues(t *testing.T) {
+	cases := map[string][]string{
+		"single page": {`[{"slug":"a"},{"slug":"b"}]`},
+		"multi page":  {`[{"slug":"a"}]`, `[{"slug":"b"}]`, `[{"slug":"c"}]`},
+		"empty":       {`[]`},
+	}
+
+	expected := map[string]int{
+		"single page": 2,
+		"multi page":  3,
+		"empty":       0,
+	}
+
+	for name, pages := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := testClient(t, testPagedHandler(t, pages...))
+
+			values, err := client.GetAllValues("2.0/test")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(values) != expected[name] {
+				t.Errorf("expected %d values, got %d", expected[name], len(values))
+			}
+		})
+	}
+}
+
+func TestClientGetAllValues_maxPages(t *testing.T) {
+	client := testClient(t, testPagedHandler(t, `[{"slug":"a"}]`, `[{"slug":"b"}]`, `[{"slug":"c"}]`))
+	client.MaxPages = 2
+
+	if _, err := client.GetAllValues("2.0/test"); err == nil {
+		t.Fatal("expected an error once the page cap is reached")
+	}
+}
+
+func TestClientGetAllValues_pageError(t *testing.T) {
+	var requests int
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("page") == "2" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprintf(w, `{"values":[{"slug":"a"}],"next":"http://%s%s?page=2"}`, r.Host, r.URL.Path)
+	})
+
+	values, err := client.GetAllValues("2.0/test")
+	if err == nil {
+		t.Fatal("expected the error of the second page to be returned")
+	}
+
+	if values != nil {
+		t.Errorf("expected no partial values, got %v", values)
+	}
+
+	if requests != 2 {
+		t.Errorf("expected iteration to stop at the failing page, got %d requests", requests)
+	}
+}
+
+func TestClientDoConditional(t *testing.T) {
+	var ifNoneMatch []string
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch = append(ifNoneMatch, r.Header.Get("If-None-Match"))
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"slug":"test"}`))
+	})
+
+	body, notModified, err := client.DoConditional("2.0/test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if notModified || string(body) != `{"slug":"test"}` {
+		t.Errorf("expected a fresh body on the first request, got %q (not modified: %t)", body, notModified)
+	}
+
+	body, notModified, err = client.DoConditional("2.0/test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !notModified || string(body) != `{"slug":"test"}` {
+		t.Errorf("expected the cached body on a 304, got %q (not modified: %t)", body, notModified)
+	}
+
+	if len(ifNoneMatch) != 2 || ifNoneMatch[0] != "" || ifNoneMatch[1] != `"v1"` {
+		t.Errorf("unexpected If-None-Match headers %q", ifNoneMatch)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	min := 100 * time.Millisecond
+	max := time.Second
+
+	for attempt, ceiling := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		wait := backoff(min, max, attempt)
+		if wait < ceiling/2 || wait > ceiling {
+			t.Errorf("attempt %d: expected wait between %s and %s, got %s", attempt, ceiling/2, ceiling, wait)
+		}
+	}
+
+	if wait := backoff(0, max, 3); wait != 0 {
+		t.Errorf("expected no wait without a base delay, got %s", wait)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if wait := parseRetryAfter("2"); wait != 2*time.Second {
+		t.Errorf("expected 2s for delta-seconds, got %s", wait)
+	}
+
+	if wait := parseRetryAfter(""); wait != 0 {
+		t.Errorf("expected 0 for empty header, got %s", wait)
+	}
+
+	if wait := parseRetryAfter("soon"); wait != 0 {
+		t.Errorf("expected 0 for invalid header, got %s", wait)
+	}
+
+	date := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	if wait := parseRetryAfter(date); wait <= 0 || wait > 10*time.Second {
+		t.Errorf("expected a wait of up to 10s for HTTP-date, got %s", wait)
+	}
+
+	past := time.Now().Add(-10 * time.Second).UTC().Format(http.TimeFormat)
+	if wait := parseRetryAfter(past); wait != 0 {
+		t.Errorf("expected 0 for a date in the past, got %s", wait)
+	}
+}
@@ -0,0 +1,120 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataBranchRestrictions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadBranchRestrictions,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"branch_restrictions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"kind": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"branch_match_kind": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"branch_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pattern": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"users": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"groups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"owner": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"slug": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataReadBranchRestrictions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+
+	// Nothing is configured here, users are reported by UUID and groups
+	// with the owner from their full slug.
+	none := schema.NewSet(schema.HashString, nil)
+
+	var restrictions []interface{}
+	err := client.GetPaged(fmt.Sprintf("2.0/repositories/%s/%s/branch-restrictions", workspace, repo), func(value json.RawMessage) error {
+		var restriction BranchRestriction
+		if err := json.Unmarshal(value, &restriction); err != nil {
+			return err
+		}
+
+		restrictions = append(restrictions, map[string]interface{}{
+			"id":                restriction.ID,
+			"kind":              restriction.Kind,
+			"branch_match_kind": restriction.BranchMatchkind,
+			"branch_type":       restriction.BranchType,
+			"pattern":           restriction.Pattern,
+			"value":             restriction.numericValue(),
+			"users":             flattenBranchRestrictionUsers(restriction.Users, none),
+			"groups":            flattenBranchRestrictionGroups(restriction.Groups, none),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, repo))
+	d.Set("branch_restrictions", restrictions)
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceBranchRestrictions_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_branch_restrictions.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketBranchRestrictionsConfig(workspace, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "branch_restrictions.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "branch_restrictions.*", map[string]string{
+						"kind":              "force",
+						"branch_match_kind": "glob",
+						"pattern":           "master",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "branch_restrictions.*", map[string]string{
+						"kind":              "require_approvals_to_merge",
+						"branch_match_kind": "branching_model",
+						"branch_type":       "production",
+						"value":             "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadBranchRestrictions_kinds(t *testing.T) {
+	client := testClient(t, testPagedHandler(t,
+		`[{"id":1,"kind":"push","branch_match_kind":"glob","pattern":"main","users":[{"uuid":"{user}","username":"someone"}],"groups":[{"slug":"developers","full_slug":"example:developers"}]},{"id":2,"kind":"require_approvals_to_merge","branch_match_kind":"branching_model","branch_type":"release","value":2,"users":[],"groups":[]}]`,
+		`[{"id":3,"kind":"force","branch_match_kind":"glob","pattern":"*","users":[],"groups":[]}]`,
+	))
+
+	d := schema.TestResourceDataRaw(t, dataBranchRestrictions().Schema, map[string]interface{}{
+		"workspace":  "example",
+		"repository": "repo",
+	})
+
+	if diags := dataReadBranchRestrictions(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []map[string]interface{}{
+		{"id": 1, "kind": "push", "branch_match_kind": "glob", "branch_type": "", "pattern": "main", "value": 0},
+		{"id": 2, "kind": "require_approvals_to_merge", "branch_match_kind": "branching_model", "branch_type": "release", "pattern": "", "value": 2},
+		{"id": 3, "kind": "force", "branch_match_kind": "glob", "branch_type": "", "pattern": "*", "value": 0},
+	}
+
+	restrictions := d.Get("branch_restrictions").([]interface{})
+	if len(restrictions) != len(expected) {
+		t.Fatalf("expected %d branch restrictions, got %d", len(expected), len(restrictions))
+	}
+
+	for i, restriction := range restrictions {
+		restriction := restriction.(map[string]interface{})
+		for k, v := range expected[i] {
+			if got := restriction[k]; got != v {
+				t.Errorf("expected branch restriction %d %s to be %v, got %v", i, k, v, got)
+			}
+		}
+	}
+
+	if got := d.Get("branch_restrictions.0.users.0").(string); got != "{user}" {
+		t.Errorf("expected push restriction user to be {user}, got %s", got)
+	}
+
+	if got := d.Get("branch_restrictions.0.groups.0.owner").(string); got != "example" {
+		t.Errorf("expected push restriction group owner to be example, got %s", got)
+	}
+}
+
+func testAccBitbucketBranchRestrictionsConfig(workspace, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_branch_restriction" "force" {
+  owner      = %[1]q
+  repository = bitbucket_repository.test.name
+  kind       = "force"
+  pattern    = "master"
+}
+
+resource "bitbucket_branch_restriction" "approvals" {
+  owner             = %[1]q
+  repository        = bitbucket_repository.test.name
+  kind              = "require_approvals_to_merge"
+  branch_match_kind = "branching_model"
+  branch_type       = "production"
+  value             = 2
+}
+
+data "bitbucket_branch_restrictions" "test" {
+  workspace  = %[1]q
+  repository = bitbucket_repository.test.name
+
+  depends_on = [
+    bitbucket_branch_restriction.force,
+    bitbucket_branch_restriction.approvals,
+  ]
+}
+`, workspace, rName)
+}
@@ -2,9 +2,9 @@ package bitbucket
 
 import (
 	"context"
-	"encoding/json"
-	"io"
+	"errors"
 	"log"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,6 +36,14 @@ func dataCurrentUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"nickname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"uuid": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -65,41 +73,34 @@ func dataCurrentUser() *schema.Resource {
 }
 
 func dataReadCurrentUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-	httpClient := m.(Clients).httpClient
-	usersApi := c.ApiClient.UsersApi
+	client := m.(Clients).httpClient
 
-	curUser, _, err := usersApi.UserGet(c.AuthContext)
-	if err := handleClientError(err); err != nil {
-		return diag.FromErr(err)
-	}
-
-	log.Printf("[DEBUG] Current User: %#v", curUser)
-
-	curUserEmails, err := httpClient.Get("2.0/user/emails")
+	curUser, err := getUser(client, "2.0/user")
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	emailBody, readerr := io.ReadAll(curUserEmails.Body)
-	if readerr != nil {
-		return diag.FromErr(readerr)
-	}
-
-	log.Printf("[DEBUG] Current User Emails Response JSON: %v", string(emailBody))
+	log.Printf("[DEBUG] Current User: %#v", curUser)
 
+	// Reading the emails needs the email scope on top of account, which
+	// OAuth consumers don't always have.
 	var emails PaginatedUserEmails
+	err = client.DoAndDecode(http.MethodGet, "2.0/user/emails", nil, &emails)
 
-	decodeerr := json.Unmarshal(emailBody, &emails)
-	if decodeerr != nil {
-		return diag.FromErr(decodeerr)
+	var apiError Error
+	if errors.As(err, &apiError) && apiError.StatusCode == http.StatusForbidden {
+		log.Printf("[WARN] Not allowed to read the emails of the current user, leaving them empty: %s", err)
+	} else if err != nil {
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Current User Emails Response Decoded: %#v", emails)
 
 	d.SetId(curUser.Uuid)
 	d.Set("uuid", curUser.Uuid)
+	d.Set("account_id", curUser.AccountId)
 	d.Set("username", curUser.Username)
+	d.Set("nickname", curUser.Nickname)
 	d.Set("display_name", curUser.DisplayName)
 	d.Set("email", flattenUserEmails(emails.Values))
 
@@ -1,9 +1,12 @@
 package bitbucket
 
 import (
+	"context"
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccDataSourceCurrentUser_basic(t *testing.T) {
@@ -18,6 +21,8 @@ func TestAccDataSourceCurrentUser_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "uuid"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "username"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "display_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "account_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "nickname"),
 					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "email.*", map[string]string{
 						"is_primary": "true",
 					}),
@@ -27,6 +32,56 @@ func TestAccDataSourceCurrentUser_basic(t *testing.T) {
 	})
 }
 
+func TestDataReadCurrentUser(t *testing.T) {
+	for name, emailStatus := range map[string]int{
+		"with emails":    http.StatusOK,
+		"without emails": http.StatusForbidden,
+	} {
+		client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/2.0/user":
+				w.Write([]byte(`{"uuid":"{user}","account_id":"123:abc","nickname":"someone","display_name":"Some One","username":"someone"}`))
+			case "/2.0/user/emails":
+				w.WriteHeader(emailStatus)
+				if emailStatus == http.StatusOK {
+					w.Write([]byte(`{"values":[{"email":"someone@example.com","is_primary":true,"is_confirmed":true}]}`))
+				} else {
+					w.Write([]byte(`{"type":"error","error":{"message":"Access denied"}}`))
+				}
+			default:
+				t.Errorf("unexpected request to %s", r.URL.Path)
+			}
+		})
+
+		d := schema.TestResourceDataRaw(t, dataCurrentUser().Schema, map[string]interface{}{})
+
+		if diags := dataReadCurrentUser(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+			t.Fatalf("%s: unexpected error: %v", name, diags)
+		}
+
+		expected := map[string]string{
+			"uuid":         "{user}",
+			"account_id":   "123:abc",
+			"nickname":     "someone",
+			"display_name": "Some One",
+			"username":     "someone",
+		}
+		for k, v := range expected {
+			if got := d.Get(k).(string); got != v {
+				t.Errorf("%s: expected %s to be %q, got %q", name, k, v, got)
+			}
+		}
+
+		expectedEmails := 1
+		if emailStatus != http.StatusOK {
+			expectedEmails = 0
+		}
+		if got := d.Get("email").(*schema.Set).Len(); got != expectedEmails {
+			t.Errorf("%s: expected %d emails, got %d", name, expectedEmails, got)
+		}
+	}
+}
+
 func testAccBitbucketCurrentUserConfig() string {
 	return `
 data "bitbucket_current_user" "test" {}
@@ -0,0 +1,84 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/DrFaust92/bitbucket-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataDefaultReviewers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadDefaultReviewers,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"reviewers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nickname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataReadDefaultReviewers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+
+	var reviewers []interface{}
+	err := client.GetPaged(fmt.Sprintf("2.0/repositories/%s/%s/default-reviewers", workspace, repo), func(value json.RawMessage) error {
+		var reviewer bitbucket.User
+		if err := json.Unmarshal(value, &reviewer); err != nil {
+			return err
+		}
+
+		reviewers = append(reviewers, map[string]interface{}{
+			"uuid":         reviewer.Uuid,
+			"account_id":   reviewer.AccountId,
+			"nickname":     reviewer.Nickname,
+			"display_name": reviewer.DisplayName,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, repo))
+	d.Set("reviewers", reviewers)
+
+	return nil
+}
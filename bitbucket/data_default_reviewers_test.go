@@ -0,0 +1,93 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceDefaultReviewers_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_default_reviewers.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDataDefaultReviewersConfig(workspace, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "reviewers.0.uuid", "data.bitbucket_current_user.test", "uuid"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "reviewers.0.account_id", "data.bitbucket_current_user.test", "account_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadDefaultReviewers_paginated(t *testing.T) {
+	client := testClient(t, testPagedHandler(t,
+		`[{"uuid":"{1}","account_id":"1:one","nickname":"one","display_name":"One"},{"uuid":"{2}","account_id":"2:two","nickname":"two","display_name":"Two"}]`,
+		`[{"uuid":"{3}","account_id":"3:three","nickname":"three","display_name":"Three"}]`,
+	))
+
+	d := schema.TestResourceDataRaw(t, dataDefaultReviewers().Schema, map[string]interface{}{
+		"workspace":  "example",
+		"repository": "repo",
+	})
+
+	if diags := dataReadDefaultReviewers(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []map[string]interface{}{
+		{"uuid": "{1}", "account_id": "1:one", "nickname": "one", "display_name": "One"},
+		{"uuid": "{2}", "account_id": "2:two", "nickname": "two", "display_name": "Two"},
+		{"uuid": "{3}", "account_id": "3:three", "nickname": "three", "display_name": "Three"},
+	}
+
+	reviewers := d.Get("reviewers").([]interface{})
+	if len(reviewers) != len(expected) {
+		t.Fatalf("expected %d reviewers, got %d", len(expected), len(reviewers))
+	}
+
+	for i, reviewer := range reviewers {
+		reviewer := reviewer.(map[string]interface{})
+		for k, v := range expected[i] {
+			if got := reviewer[k]; got != v {
+				t.Errorf("expected reviewer %d %s to be %v, got %v", i, k, v, got)
+			}
+		}
+	}
+}
+
+func testAccBitbucketDataDefaultReviewersConfig(workspace, rName string) string {
+	return fmt.Sprintf(`
+data "bitbucket_current_user" "test" {}
+
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_default_reviewers" "test" {
+  owner      = %[1]q
+  repository = bitbucket_repository.test.name
+  reviewers  = [data.bitbucket_current_user.test.uuid]
+}
+
+data "bitbucket_default_reviewers" "test" {
+  workspace  = %[1]q
+  repository = bitbucket_repository.test.name
+
+  depends_on = [bitbucket_default_reviewers.test]
+}
+`, workspace, rName)
+}
@@ -0,0 +1,100 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataDeploymentEnvironments() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadDeploymentEnvironments,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rank": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataReadDeploymentEnvironments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+
+	var deployments []Deployment
+	err := client.GetPaged(fmt.Sprintf("2.0/repositories/%s/%s/environments", workspace, repo), func(value json.RawMessage) error {
+		var deployment Deployment
+		if err := json.Unmarshal(value, &deployment); err != nil {
+			return err
+		}
+
+		deployments = append(deployments, deployment)
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Bitbucket orders environments by rank within a page, keep that order
+	// across pages too so the list matches the deployments UI.
+	sort.SliceStable(deployments, func(i, j int) bool {
+		return deployments[i].Rank < deployments[j].Rank
+	})
+
+	environments := make([]interface{}, 0, len(deployments))
+	for _, deployment := range deployments {
+		environment := map[string]interface{}{
+			"uuid": deployment.UUID,
+			"name": deployment.Name,
+			"rank": deployment.Rank,
+		}
+
+		if deployment.Stage != nil {
+			environment["environment_type"] = deployment.Stage.Name
+		}
+
+		environments = append(environments, environment)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, repo))
+	d.Set("environments", environments)
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceDeploymentEnvironments_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_deployment_environments.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDeploymentEnvironmentsConfig(workspace, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "environments.*", map[string]string{
+						"name":             rName,
+						"environment_type": "Staging",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "environments.*.uuid", "bitbucket_deployment.test", "uuid"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadDeploymentEnvironments_rankOrder(t *testing.T) {
+	client := testClient(t, testPagedHandler(t,
+		`[{"uuid":"{1}","name":"Test","rank":0,"environment_type":{"name":"Test"}},{"uuid":"{2}","name":"Staging","rank":1,"environment_type":{"name":"Staging"}},{"uuid":"{4}","name":"Production","rank":3,"environment_type":{"name":"Production"}}]`,
+		`[{"uuid":"{3}","name":"Staging EU","rank":2,"environment_type":{"name":"Staging"}}]`,
+	))
+
+	d := schema.TestResourceDataRaw(t, dataDeploymentEnvironments().Schema, map[string]interface{}{
+		"workspace":  "example",
+		"repository": "repo",
+	})
+
+	if diags := dataReadDeploymentEnvironments(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []map[string]interface{}{
+		{"uuid": "{1}", "name": "Test", "environment_type": "Test", "rank": 0},
+		{"uuid": "{2}", "name": "Staging", "environment_type": "Staging", "rank": 1},
+		{"uuid": "{3}", "name": "Staging EU", "environment_type": "Staging", "rank": 2},
+		{"uuid": "{4}", "name": "Production", "environment_type": "Production", "rank": 3},
+	}
+
+	environments := d.Get("environments").([]interface{})
+	if len(environments) != len(expected) {
+		t.Fatalf("expected %d environments, got %d", len(expected), len(environments))
+	}
+
+	for i, environment := range environments {
+		environment := environment.(map[string]interface{})
+		for k, v := range expected[i] {
+			if got := environment[k]; got != v {
+				t.Errorf("expected environment %d %s to be %v, got %v", i, k, v, got)
+			}
+		}
+	}
+}
+
+func testAccBitbucketDeploymentEnvironmentsConfig(workspace, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_deployment" "test" {
+  name       = %[2]q
+  stage      = "Staging"
+  repository = bitbucket_repository.test.id
+}
+
+data "bitbucket_deployment_environments" "test" {
+  workspace  = %[1]q
+  repository = bitbucket_repository.test.name
+
+  depends_on = [bitbucket_deployment.test]
+}
+`, workspace, rName)
+}
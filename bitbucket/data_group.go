@@ -2,13 +2,13 @@ package bitbucket
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"log"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataGroup() *schema.Resource {
@@ -21,12 +21,18 @@ func dataGroup() *schema.Resource {
 				Required: true,
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"slug", "name"},
 			},
 			"slug": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"slug", "name"},
 			},
 			"auto_add": {
 				Type:     schema.TypeBool,
@@ -49,30 +55,27 @@ func dataReadGroup(ctx context.Context, d *schema.ResourceData, m interface{}) d
 
 	workspace := d.Get("workspace").(string)
 	slug := d.Get("slug").(string)
+	name := d.Get("name").(string)
 
-	groupsReq, _ := client.Get(fmt.Sprintf("1.0/groups/%s/%s", workspace, slug))
-
-	if groupsReq.Body == nil {
-		return diag.Errorf("error reading Group (%s): empty response", d.Id())
-	}
-
-	var grp *UserGroup
-
-	body, readerr := io.ReadAll(groupsReq.Body)
-	if readerr != nil {
-		return diag.FromErr(readerr)
+	// There is no endpoint for a single group, so the group is looked up in
+	// the groups of the workspace.
+	var grps []*UserGroup
+	err := client.DoAndDecode(http.MethodGet, fmt.Sprintf("1.0/groups/%s", workspace), nil, &grps)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	log.Printf("[DEBUG] Group Response JSON: %v", string(body))
+	log.Printf("[DEBUG] Groups Response Decoded: %#v", grps)
 
-	decodeerr := json.Unmarshal(body, &grp)
-	if decodeerr != nil {
-		return diag.FromErr(decodeerr)
+	grp := findUserGroup(grps, slug, name)
+	if grp == nil {
+		if slug != "" {
+			return diag.Errorf("no group with slug %q found in workspace %s", slug, workspace)
+		}
+		return diag.Errorf("no group named %q found in workspace %s", name, workspace)
 	}
 
-	log.Printf("[DEBUG] Group Response Decoded: %#v", grp)
-
-	d.SetId(fmt.Sprintf("%s/%s", workspace, slug))
+	d.SetId(fmt.Sprintf("%s/%s", workspace, grp.Slug))
 	d.Set("workspace", workspace)
 	d.Set("slug", grp.Slug)
 	d.Set("name", grp.Name)
@@ -82,3 +85,19 @@ func dataReadGroup(ctx context.Context, d *schema.ResourceData, m interface{}) d
 
 	return nil
 }
+
+// findUserGroup returns the group with the slug, or the name when no slug is
+// given.
+func findUserGroup(grps []*UserGroup, slug, name string) *UserGroup {
+	for _, grp := range grps {
+		if grp == nil {
+			continue
+		}
+
+		if (slug != "" && grp.Slug == slug) || (slug == "" && grp.Name == name) {
+			return grp
+		}
+	}
+
+	return nil
+}
@@ -1,12 +1,15 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccDataSourceDataGroup_basic(t *testing.T) {
@@ -34,6 +37,91 @@ func TestAccDataSourceDataGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceDataGroup_name(t *testing.T) {
+	dataSourceName := "data.bitbucket_group.test"
+	groupResourceName := "bitbucket_group.test"
+
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketGroupDataNameConfig(workspace, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "slug", groupResourceName, "slug"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", groupResourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadGroup(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/1.0/groups/example" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+
+		w.Write([]byte(`[{"name":"Developers","slug":"developers","permission":"write","auto_add":true},{"name":"Readers","slug":"readers","permission":"read"}]`))
+	})
+
+	for name, tc := range map[string]struct {
+		raw          map[string]interface{}
+		expectedSlug string
+		expectedErr  string
+	}{
+		"slug":         {raw: map[string]interface{}{"slug": "readers"}, expectedSlug: "readers"},
+		"name":         {raw: map[string]interface{}{"name": "Developers"}, expectedSlug: "developers"},
+		"unknown slug": {raw: map[string]interface{}{"slug": "missing"}, expectedErr: `no group with slug "missing" found in workspace example`},
+		"unknown name": {raw: map[string]interface{}{"name": "Missing"}, expectedErr: `no group named "Missing" found in workspace example`},
+	} {
+		tc.raw["workspace"] = "example"
+		d := schema.TestResourceDataRaw(t, dataGroup().Schema, tc.raw)
+
+		diags := dataReadGroup(context.Background(), d, Clients{httpClient: client})
+		if tc.expectedErr != "" {
+			if !diags.HasError() || diags[0].Summary != tc.expectedErr {
+				t.Errorf("%s: expected error %q, got %v", name, tc.expectedErr, diags)
+			}
+			continue
+		}
+
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected error: %v", name, diags)
+		}
+
+		if got := d.Get("slug").(string); got != tc.expectedSlug {
+			t.Errorf("%s: expected slug %q, got %q", name, tc.expectedSlug, got)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, dataGroup().Schema, map[string]interface{}{"workspace": "example", "name": "Developers"})
+	if diags := dataReadGroup(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Get("permission").(string) != "write" || !d.Get("auto_add").(bool) {
+		t.Errorf("expected the attributes of the developers group, got permission %q and auto_add %t", d.Get("permission"), d.Get("auto_add"))
+	}
+}
+
+func testAccBitbucketGroupDataNameConfig(workspace, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_group" "test" {
+  workspace = %[1]q
+  name      = %[2]q
+}
+
+data "bitbucket_group" "test" {
+  workspace = %[1]q
+  name      = bitbucket_group.test.name
+}
+`, workspace, rName)
+}
+
 func testAccBitbucketGroupDataConfig(workspace, rName string) string {
 	return fmt.Sprintf(`
 data "bitbucket_workspace" "test" {
@@ -0,0 +1,85 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataProject() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadProject,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_private": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_publicly_visible_repos": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataReadProject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(Clients).genClient
+	projectApi := c.ApiClient.ProjectsApi
+
+	workspace := d.Get("workspace").(string)
+	key := d.Get("key").(string)
+
+	project, _, err := projectApi.WorkspacesWorkspaceProjectsProjectKeyGet(c.AuthContext, key, workspace)
+	if err := handleClientError(err); err != nil {
+		return diag.Errorf("error reading Project (%s/%s): %s", workspace, key, err)
+	}
+
+	log.Printf("[DEBUG] Project: %#v", project)
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, project.Key))
+	d.Set("workspace", workspace)
+	d.Set("key", project.Key)
+	d.Set("uuid", project.Uuid)
+	d.Set("name", project.Name)
+	d.Set("description", project.Description)
+	d.Set("is_private", project.IsPrivate)
+	d.Set("has_publicly_visible_repos", project.HasPubliclyVisibleRepos)
+
+	if project.CreatedOn != nil {
+		d.Set("created_on", project.CreatedOn.Format(time.RFC3339))
+	}
+
+	return nil
+}
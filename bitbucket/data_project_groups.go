@@ -0,0 +1,78 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataProjectGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadProjectGroups,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project_key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permission": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataReadProjectGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	projectKey := d.Get("project_key").(string)
+
+	var groups []interface{}
+	err := client.GetPaged(fmt.Sprintf("2.0/workspaces/%s/projects/%s/permissions-config/groups", workspace, projectKey), func(value json.RawMessage) error {
+		var permission ProjectGroupPermission
+		if err := json.Unmarshal(value, &permission); err != nil {
+			return err
+		}
+
+		groupSlug := ""
+		if permission.Group != nil {
+			groupSlug = permission.Group.Slug
+		}
+
+		groups = append(groups, map[string]interface{}{
+			"group_slug": groupSlug,
+			"permission": permission.Permission,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, projectKey))
+	d.Set("groups", groups)
+
+	return nil
+}
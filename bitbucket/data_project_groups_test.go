@@ -0,0 +1,98 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceProjectGroups_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_project_groups.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketProjectGroupsConfig(workspace, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "groups.0.group_slug", "bitbucket_group.test", "slug"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.permission", "write"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadProjectGroups_paginated(t *testing.T) {
+	client := testClient(t, testPagedHandler(t,
+		`[{"permission":"admin","group":{"slug":"admins"}},{"permission":"write","group":{"slug":"developers"}}]`,
+		`[{"permission":"read","group":{"slug":"readers"}}]`,
+	))
+
+	d := schema.TestResourceDataRaw(t, dataProjectGroups().Schema, map[string]interface{}{
+		"workspace":   "example",
+		"project_key": "PROJ",
+	})
+
+	if diags := dataReadProjectGroups(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []map[string]interface{}{
+		{"group_slug": "admins", "permission": "admin"},
+		{"group_slug": "developers", "permission": "write"},
+		{"group_slug": "readers", "permission": "read"},
+	}
+
+	groups := d.Get("groups").([]interface{})
+	if len(groups) != len(expected) {
+		t.Fatalf("expected %d groups, got %d", len(expected), len(groups))
+	}
+
+	for i, group := range groups {
+		group := group.(map[string]interface{})
+		for k, v := range expected[i] {
+			if got := group[k]; got != v {
+				t.Errorf("expected group %d %s to be %v, got %v", i, k, v, got)
+			}
+		}
+	}
+}
+
+func testAccBitbucketProjectGroupsConfig(workspace, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_project" "test" {
+  owner = %[1]q
+  name  = %[2]q
+  key   = "AAAAAA"
+}
+
+resource "bitbucket_group" "test" {
+  workspace = %[1]q
+  name      = %[2]q
+}
+
+resource "bitbucket_project_group_permission" "test" {
+  workspace   = %[1]q
+  project_key = bitbucket_project.test.key
+  group_slug  = bitbucket_group.test.slug
+  permission  = "write"
+}
+
+data "bitbucket_project_groups" "test" {
+  workspace   = %[1]q
+  project_key = bitbucket_project.test.key
+
+  depends_on = [bitbucket_project_group_permission.test]
+}
+`, workspace, rName)
+}
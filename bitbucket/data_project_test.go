@@ -0,0 +1,52 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceProject_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_project.test"
+	resourceName := "bitbucket_project.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketProjectDataConfig(workspace, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "workspace", workspace),
+					resource.TestCheckResourceAttrPair(dataSourceName, "key", resourceName, "key"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "uuid", resourceName, "uuid"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttr(dataSourceName, "is_private", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "created_on"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBitbucketProjectDataConfig(workspace, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_project" "test" {
+  owner       = %[1]q
+  name        = %[2]q
+  key         = "DSPROJ"
+  description = "data source test"
+}
+
+data "bitbucket_project" "test" {
+  workspace = %[1]q
+  key       = bitbucket_project.test.key
+}
+`, workspace, rName)
+}
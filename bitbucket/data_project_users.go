@@ -0,0 +1,78 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataProjectUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadProjectUsers,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project_key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permission": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataReadProjectUsers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	projectKey := d.Get("project_key").(string)
+
+	var users []interface{}
+	err := client.GetPaged(fmt.Sprintf("2.0/workspaces/%s/projects/%s/permissions-config/users", workspace, projectKey), func(value json.RawMessage) error {
+		var permission WorkspacePermission
+		if err := json.Unmarshal(value, &permission); err != nil {
+			return err
+		}
+
+		users = append(users, map[string]interface{}{
+			"user_uuid":  permission.User.Uuid,
+			"account_id": permission.User.AccountId,
+			"permission": permission.Permission,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, projectKey))
+	d.Set("users", users)
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceProjectUsers_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_project_users.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketProjectUsersConfig(workspace, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "users.0.user_uuid", "data.bitbucket_current_user.test", "uuid"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.permission", "read"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadProjectUsers_empty(t *testing.T) {
+	client := testClient(t, testPagedHandler(t, `[]`))
+
+	d := schema.TestResourceDataRaw(t, dataProjectUsers().Schema, map[string]interface{}{
+		"workspace":   "example",
+		"project_key": "PROJ",
+	})
+
+	if diags := dataReadProjectUsers(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "example/PROJ" {
+		t.Errorf("expected ID to be example/PROJ, got %s", d.Id())
+	}
+
+	if users := d.Get("users").([]interface{}); len(users) != 0 {
+		t.Errorf("expected no users, got %d", len(users))
+	}
+}
+
+func TestDataReadProjectUsers_paginated(t *testing.T) {
+	client := testClient(t, testPagedHandler(t,
+		`[{"permission":"admin","user":{"uuid":"{1}","account_id":"1:one"}},{"permission":"write","user":{"uuid":"{2}","account_id":"2:two"}}]`,
+		`[{"permission":"read","user":{"uuid":"{3}","account_id":"3:three"}}]`,
+	))
+
+	d := schema.TestResourceDataRaw(t, dataProjectUsers().Schema, map[string]interface{}{
+		"workspace":   "example",
+		"project_key": "PROJ",
+	})
+
+	if diags := dataReadProjectUsers(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []map[string]interface{}{
+		{"user_uuid": "{1}", "account_id": "1:one", "permission": "admin"},
+		{"user_uuid": "{2}", "account_id": "2:two", "permission": "write"},
+		{"user_uuid": "{3}", "account_id": "3:three", "permission": "read"},
+	}
+
+	users := d.Get("users").([]interface{})
+	if len(users) != len(expected) {
+		t.Fatalf("expected %d users, got %d", len(expected), len(users))
+	}
+
+	for i, user := range users {
+		user := user.(map[string]interface{})
+		for k, v := range expected[i] {
+			if got := user[k]; got != v {
+				t.Errorf("expected user %d %s to be %v, got %v", i, k, v, got)
+			}
+		}
+	}
+}
+
+func testAccBitbucketProjectUsersConfig(workspace, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_project" "test" {
+  owner = %[1]q
+  name  = %[2]q
+  key   = "AAAAAA"
+}
+
+data "bitbucket_current_user" "test" {}
+
+resource "bitbucket_project_user_permission" "test" {
+  workspace   = %[1]q
+  project_key = bitbucket_project.test.key
+  user_id     = data.bitbucket_current_user.test.id
+  permission  = "read"
+}
+
+data "bitbucket_project_users" "test" {
+  workspace   = %[1]q
+  project_key = bitbucket_project.test.key
+
+  depends_on = [bitbucket_project_user_permission.test]
+}
+`, workspace, rName)
+}
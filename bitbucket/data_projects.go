@@ -0,0 +1,89 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/DrFaust92/bitbucket-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataProjects() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadProjects,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"query": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"projects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_private": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataReadProjects(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+
+	params := url.Values{}
+	if v, ok := d.GetOk("query"); ok {
+		params.Set("q", v.(string))
+	}
+
+	var projects []interface{}
+	err := client.GetPagedWithQuery(fmt.Sprintf("2.0/workspaces/%s/projects", workspace), params, func(value json.RawMessage) error {
+		var project bitbucket.Project
+		if err := json.Unmarshal(value, &project); err != nil {
+			return err
+		}
+
+		projects = append(projects, map[string]interface{}{
+			"key":        project.Key,
+			"uuid":       project.Uuid,
+			"name":       project.Name,
+			"is_private": project.IsPrivate,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(workspace)
+	d.Set("projects", projects)
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceProjects_query(t *testing.T) {
+	dataSourceName := "data.bitbucket_projects.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketProjectsConfig(workspace, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "projects.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "projects.0.key", "bitbucket_project.test", "key"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "projects.0.uuid", "bitbucket_project.test", "uuid"),
+					resource.TestCheckResourceAttr(dataSourceName, "projects.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "projects.0.is_private", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadProjects_paginated(t *testing.T) {
+	pages := testPagedHandler(t,
+		`[{"key":"ONE","uuid":"{1}","name":"one","is_private":true},{"key":"TWO","uuid":"{2}","name":"two"}]`,
+		`[{"key":"THREE","uuid":"{3}","name":"three","is_private":true}]`,
+	)
+
+	var query string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "" {
+			query = r.URL.Query().Get("q")
+		}
+		pages(w, r)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataProjects().Schema, map[string]interface{}{
+		"workspace": "example",
+		"query":     `name ~ "t"`,
+	})
+
+	if diags := dataReadProjects(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if query != `name ~ "t"` {
+		t.Errorf("expected the query to be sent as q, got %q", query)
+	}
+
+	expected := []map[string]interface{}{
+		{"key": "ONE", "uuid": "{1}", "name": "one", "is_private": true},
+		{"key": "TWO", "uuid": "{2}", "name": "two", "is_private": false},
+		{"key": "THREE", "uuid": "{3}", "name": "three", "is_private": true},
+	}
+
+	projects := d.Get("projects").([]interface{})
+	if len(projects) != len(expected) {
+		t.Fatalf("expected %d projects, got %d", len(expected), len(projects))
+	}
+
+	for i, project := range projects {
+		project := project.(map[string]interface{})
+		for k, v := range expected[i] {
+			if got := project[k]; got != v {
+				t.Errorf("expected project %d %s to be %v, got %v", i, k, v, got)
+			}
+		}
+	}
+}
+
+func testAccBitbucketProjectsConfig(workspace, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_project" "test" {
+  owner = %[1]q
+  name  = %[2]q
+  key   = "AAAAAA"
+}
+
+data "bitbucket_projects" "test" {
+  workspace = %[1]q
+  query     = "key = \"${bitbucket_project.test.key}\""
+}
+`, workspace, rName)
+}
@@ -0,0 +1,116 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/DrFaust92/bitbucket-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataRepositories() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadRepositories,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"query": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"repositories": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_private": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"project_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// repositoriesQuery combines the project filter with a user supplied query
+// into a single Bitbucket filter query.
+func repositoriesQuery(projectKey, query string) string {
+	switch {
+	case projectKey == "":
+		return query
+	case query == "":
+		return fmt.Sprintf("project.key=%q", projectKey)
+	default:
+		return fmt.Sprintf("project.key=%q AND (%s)", projectKey, query)
+	}
+}
+
+func dataReadRepositories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+
+	params := url.Values{}
+	if q := repositoriesQuery(d.Get("project_key").(string), d.Get("query").(string)); q != "" {
+		params.Set("q", q)
+	}
+
+	var repositories []interface{}
+	err := client.GetPagedWithQuery(fmt.Sprintf("2.0/repositories/%s", workspace), params, func(value json.RawMessage) error {
+		var repo bitbucket.Repository
+		if err := json.Unmarshal(value, &repo); err != nil {
+			return err
+		}
+
+		projectKey := ""
+		if repo.Project != nil {
+			projectKey = repo.Project.Key
+		}
+
+		repositories = append(repositories, map[string]interface{}{
+			"uuid":        repo.Uuid,
+			"slug":        repo.Slug,
+			"name":        repo.Name,
+			"is_private":  repo.IsPrivate,
+			"project_key": projectKey,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(workspace)
+	d.Set("repositories", repositories)
+
+	return nil
+}
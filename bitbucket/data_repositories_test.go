@@ -0,0 +1,125 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceRepositories_projectKey(t *testing.T) {
+	dataSourceName := "data.bitbucket_repositories.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoriesConfig(workspace, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "repositories.0.uuid", "bitbucket_repository.test", "uuid"),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.0.slug", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.0.is_private", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "repositories.0.project_key", "bitbucket_project.test", "key"),
+				),
+			},
+		},
+	})
+}
+
+func TestRepositoriesQuery(t *testing.T) {
+	cases := []struct {
+		projectKey, query, expected string
+	}{
+		{"", "", ""},
+		{"", `name ~ "api"`, `name ~ "api"`},
+		{"PROJ", "", `project.key="PROJ"`},
+		{"PROJ", `name ~ "api"`, `project.key="PROJ" AND (name ~ "api")`},
+	}
+
+	for _, c := range cases {
+		if got := repositoriesQuery(c.projectKey, c.query); got != c.expected {
+			t.Errorf("expected query for %q and %q to be %q, got %q", c.projectKey, c.query, c.expected, got)
+		}
+	}
+}
+
+func TestDataReadRepositories_projectKey(t *testing.T) {
+	pages := testPagedHandler(t,
+		`[{"uuid":"{1}","slug":"one","name":"One","is_private":true,"project":{"key":"PROJ"}},{"uuid":"{2}","slug":"two","name":"Two","project":{"key":"PROJ"}}]`,
+		`[{"uuid":"{3}","slug":"three","name":"Three","is_private":true,"project":{"key":"PROJ"}}]`,
+	)
+
+	var query string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "" {
+			query = r.URL.Query().Get("q")
+		}
+		pages(w, r)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataRepositories().Schema, map[string]interface{}{
+		"workspace":   "example",
+		"project_key": "PROJ",
+	})
+
+	if diags := dataReadRepositories(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if query != `project.key="PROJ"` {
+		t.Errorf("expected the project to be filtered server side, got q=%q", query)
+	}
+
+	expected := []map[string]interface{}{
+		{"uuid": "{1}", "slug": "one", "name": "One", "is_private": true, "project_key": "PROJ"},
+		{"uuid": "{2}", "slug": "two", "name": "Two", "is_private": false, "project_key": "PROJ"},
+		{"uuid": "{3}", "slug": "three", "name": "Three", "is_private": true, "project_key": "PROJ"},
+	}
+
+	repositories := d.Get("repositories").([]interface{})
+	if len(repositories) != len(expected) {
+		t.Fatalf("expected %d repositories, got %d", len(expected), len(repositories))
+	}
+
+	for i, repo := range repositories {
+		repo := repo.(map[string]interface{})
+		for k, v := range expected[i] {
+			if got := repo[k]; got != v {
+				t.Errorf("expected repository %d %s to be %v, got %v", i, k, v, got)
+			}
+		}
+	}
+}
+
+func testAccBitbucketRepositoriesConfig(workspace, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_project" "test" {
+  owner = %[1]q
+  name  = %[2]q
+  key   = "AAAAAA"
+}
+
+resource "bitbucket_repository" "test" {
+  owner       = %[1]q
+  name        = %[2]q
+  project_key = bitbucket_project.test.key
+}
+
+data "bitbucket_repositories" "test" {
+  workspace   = %[1]q
+  project_key = bitbucket_project.test.key
+
+  depends_on = [bitbucket_repository.test]
+}
+`, workspace, rName)
+}
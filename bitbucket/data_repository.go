@@ -0,0 +1,147 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataRepository() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadRepository,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"slug", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"slug", "name"},
+			},
+			"uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"full_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_private": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"fork_policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"language": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"has_wiki": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_issues": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"project_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mainbranch": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"clone_https": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"clone_ssh": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataReadRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(Clients).genClient
+	repoApi := c.ApiClient.RepositoriesApi
+
+	workspace := d.Get("workspace").(string)
+
+	repoSlug := d.Get("slug").(string)
+	if repoSlug == "" {
+		repoSlug = computeSlug(d.Get("name").(string))
+	}
+
+	repo, _, err := repoApi.RepositoriesWorkspaceRepoSlugGet(c.AuthContext, repoSlug, workspace)
+	if err := handleClientError(err); err != nil {
+		return diag.Errorf("error reading Repository (%s/%s): %s", workspace, repoSlug, err)
+	}
+
+	log.Printf("[DEBUG] Repository: %#v", repo)
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, repo.Slug))
+	d.Set("workspace", workspace)
+	d.Set("slug", repo.Slug)
+	d.Set("name", repo.Name)
+	d.Set("uuid", repo.Uuid)
+	d.Set("full_name", repo.FullName)
+	d.Set("scm", repo.Scm)
+	d.Set("is_private", repo.IsPrivate)
+	d.Set("fork_policy", repo.ForkPolicy)
+	d.Set("description", repo.Description)
+	d.Set("language", repo.Language)
+	d.Set("has_wiki", repo.HasWiki)
+	d.Set("has_issues", repo.HasIssues)
+
+	projectKey := ""
+	if repo.Project != nil {
+		projectKey = repo.Project.Key
+	}
+	d.Set("project_key", projectKey)
+
+	// Empty repositories don't have a main branch yet.
+	mainBranch := ""
+	if repo.Mainbranch != nil {
+		mainBranch = repo.Mainbranch.Name
+	}
+	d.Set("mainbranch", mainBranch)
+
+	if repo.Links != nil {
+		for _, cloneURL := range repo.Links.Clone {
+			if cloneURL.Name == "https" {
+				d.Set("clone_https", cloneURL.Href)
+			} else {
+				d.Set("clone_ssh", cloneURL.Href)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataRepositoryBranches() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadRepositoryBranches,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"query": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"branches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_hash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataReadRepositoryBranches(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repoSlug := d.Get("repository").(string)
+
+	params := url.Values{}
+	if v, ok := d.GetOk("query"); ok {
+		params.Set("q", v.(string))
+	}
+
+	var branches []interface{}
+	err := client.GetPagedWithQuery(fmt.Sprintf("2.0/repositories/%s/%s/refs/branches", workspace, repoSlug), params, func(value json.RawMessage) error {
+		var branch RepositoryRef
+		if err := json.Unmarshal(value, &branch); err != nil {
+			return err
+		}
+
+		targetHash := ""
+		if branch.Target != nil {
+			targetHash = branch.Target.Hash
+		}
+
+		branches = append(branches, map[string]interface{}{
+			"name":        branch.Name,
+			"target_hash": targetHash,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, repoSlug))
+	d.Set("branches", branches)
+
+	return nil
+}
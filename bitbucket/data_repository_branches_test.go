@@ -0,0 +1,97 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceRepositoryBranches_query(t *testing.T) {
+	dataSourceName := "data.bitbucket_repository_branches.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	repo := os.Getenv("BITBUCKET_PIPELINED_REPO")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckPipeSchedule(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryBranchesConfig(workspace, repo, "release/"+rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "branches.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "branches.0.name", "release/"+rName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "branches.0.target_hash", "bitbucket_branch.test", "hash"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadRepositoryBranches_paginated(t *testing.T) {
+	pages := testPagedHandler(t,
+		`[{"name":"release/1.0","target":{"hash":"aaa"}},{"name":"release/1.1","target":{"hash":"bbb"}}]`,
+		`[{"name":"release/2.0","target":{"hash":"ccc"}}]`,
+	)
+
+	var query string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "" {
+			query = r.URL.Query().Get("q")
+		}
+		pages(w, r)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataRepositoryBranches().Schema, map[string]interface{}{
+		"workspace":  "example",
+		"repository": "repo",
+		"query":      `name ~ "release/"`,
+	})
+
+	if diags := dataReadRepositoryBranches(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if query != `name ~ "release/"` {
+		t.Errorf("expected the query to be sent as q, got %q", query)
+	}
+
+	expected := []map[string]interface{}{
+		{"name": "release/1.0", "target_hash": "aaa"},
+		{"name": "release/1.1", "target_hash": "bbb"},
+		{"name": "release/2.0", "target_hash": "ccc"},
+	}
+
+	branches := d.Get("branches").([]interface{})
+	if len(branches) != len(expected) {
+		t.Fatalf("expected %d branches, got %d", len(expected), len(branches))
+	}
+
+	for i, branch := range branches {
+		branch := branch.(map[string]interface{})
+		for k, v := range expected[i] {
+			if got := branch[k]; got != v {
+				t.Errorf("expected branch %d %s to be %v, got %v", i, k, v, got)
+			}
+		}
+	}
+}
+
+func testAccBitbucketRepositoryBranchesConfig(workspace, repo, name string) string {
+	return testAccBitbucketBranchConfig(workspace, repo, name) + fmt.Sprintf(`
+data "bitbucket_repository_branches" "test" {
+  workspace  = %[1]q
+  repository = %[2]q
+  query      = "name = \"${bitbucket_branch.test.name}\""
+}
+`, workspace, repo)
+}
@@ -0,0 +1,100 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// RepositoryCommit is a commit as returned by the commit endpoint
+type RepositoryCommit struct {
+	Hash    string `json:"hash"`
+	Message string `json:"message"`
+	Date    string `json:"date"`
+	Author  struct {
+		Raw string `json:"raw"`
+	} `json:"author"`
+	Parents []struct {
+		Hash string `json:"hash"`
+	} `json:"parents"`
+}
+
+func dataRepositoryCommit() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadRepositoryCommit,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"revision": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"author": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parents": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataReadRepositoryCommit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+	revision := d.Get("revision").(string)
+
+	var commit RepositoryCommit
+	err := client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/repositories/%s/%s/commit/%s", workspace, repo, url.PathEscape(revision)), nil, &commit)
+	if IsNotFound(err) {
+		return diag.Errorf("revision %q does not resolve to a commit in repository %s/%s", revision, workspace, repo)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parents := make([]string, 0, len(commit.Parents))
+	for _, parent := range commit.Parents {
+		parents = append(parents, parent.Hash)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", workspace, repo, commit.Hash))
+	d.Set("hash", commit.Hash)
+	d.Set("message", commit.Message)
+	d.Set("date", commit.Date)
+	d.Set("author", commit.Author.Raw)
+	d.Set("parents", parents)
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// CommitStatus is the status of a build or other check reported on a commit
+type CommitStatus struct {
+	Key         string `json:"key"`
+	State       string `json:"state"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Description string `json:"description"`
+}
+
+func dataRepositoryCommitStatuses() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadRepositoryCommitStatuses,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"revision": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"statuses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataReadRepositoryCommitStatuses(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+	revision := d.Get("revision").(string)
+
+	var statuses []interface{}
+	err := client.GetPaged(fmt.Sprintf("2.0/repositories/%s/%s/commit/%s/statuses", workspace, repo, url.PathEscape(revision)), func(value json.RawMessage) error {
+		var status CommitStatus
+		if err := json.Unmarshal(value, &status); err != nil {
+			return err
+		}
+
+		statuses = append(statuses, map[string]interface{}{
+			"key":         status.Key,
+			"state":       status.State,
+			"name":        status.Name,
+			"url":         status.URL,
+			"description": status.Description,
+		})
+
+		return nil
+	})
+	if IsNotFound(err) {
+		return diag.Errorf("revision %q does not resolve to a commit in repository %s/%s", revision, workspace, repo)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", workspace, repo, revision))
+	d.Set("statuses", statuses)
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceRepositoryCommitStatuses_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_repository_commit_statuses.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	repo := os.Getenv("BITBUCKET_PIPELINED_REPO")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckPipeSchedule(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryCommitStatusesConfig(workspace, repo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "statuses.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadRepositoryCommitStatuses_paginated(t *testing.T) {
+	client := testClient(t, testPagedHandler(t,
+		`[{"key":"build","state":"SUCCESSFUL","name":"Build #12","url":"https://ci.example.com/12","description":"Passed"},{"key":"lint","state":"FAILED","name":"Lint","url":"https://ci.example.com/lint"}]`,
+		`[{"key":"deploy","state":"INPROGRESS","url":"https://ci.example.com/deploy"}]`,
+	))
+
+	d := schema.TestResourceDataRaw(t, dataRepositoryCommitStatuses().Schema, map[string]interface{}{
+		"workspace":  "example",
+		"repository": "repo",
+		"revision":   "abc123",
+	})
+
+	if diags := dataReadRepositoryCommitStatuses(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "example/repo/abc123" {
+		t.Errorf("unexpected ID %q", d.Id())
+	}
+
+	expected := []map[string]interface{}{
+		{"key": "build", "state": "SUCCESSFUL", "name": "Build #12", "url": "https://ci.example.com/12", "description": "Passed"},
+		{"key": "lint", "state": "FAILED", "name": "Lint", "url": "https://ci.example.com/lint", "description": ""},
+		{"key": "deploy", "state": "INPROGRESS", "name": "", "url": "https://ci.example.com/deploy", "description": ""},
+	}
+
+	statuses := d.Get("statuses").([]interface{})
+	if len(statuses) != len(expected) {
+		t.Fatalf("expected %d statuses, got %d", len(expected), len(statuses))
+	}
+
+	for i, status := range statuses {
+		status := status.(map[string]interface{})
+		for k, v := range expected[i] {
+			if got := status[k]; got != v {
+				t.Errorf("expected status %d %s to be %v, got %v", i, k, v, got)
+			}
+		}
+	}
+}
+
+func testAccBitbucketRepositoryCommitStatusesConfig(workspace, repo string) string {
+	return fmt.Sprintf(`
+data "bitbucket_repository" "test" {
+  workspace = %[1]q
+  slug      = %[2]q
+}
+
+data "bitbucket_repository_commit_statuses" "test" {
+  workspace  = %[1]q
+  repository = %[2]q
+  revision   = data.bitbucket_repository.test.mainbranch
+}
+`, workspace, repo)
+}
@@ -0,0 +1,113 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceRepositoryCommit_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_repository_commit.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	repo := os.Getenv("BITBUCKET_PIPELINED_REPO")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckPipeSchedule(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryCommitConfig(workspace, repo, "data.bitbucket_repository.test.mainbranch"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "hash", regexp.MustCompile(`^[0-9a-f]{40}$`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "message"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "author"),
+				),
+			},
+			{
+				Config:      testAccBitbucketRepositoryCommitConfig(workspace, repo, `"tf-test-missing-revision"`),
+				ExpectError: regexp.MustCompile(`does not resolve to a commit`),
+			},
+		},
+	})
+}
+
+func TestDataReadRepositoryCommit(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/2.0/repositories/example/repo/commit/feature%2Fmain" {
+			t.Errorf("unexpected request to %s", r.URL.EscapedPath())
+		}
+
+		w.Write([]byte(`{"hash":"abc123","message":"Fix things\n","date":"2024-01-02T03:04:05+00:00","author":{"raw":"Some One <someone@example.com>"},"parents":[{"hash":"def456"},{"hash":"fed654"}]}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, dataRepositoryCommit().Schema, map[string]interface{}{
+		"workspace":  "example",
+		"repository": "repo",
+		"revision":   "feature/main",
+	})
+
+	if diags := dataReadRepositoryCommit(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := map[string]string{
+		"hash":    "abc123",
+		"message": "Fix things\n",
+		"date":    "2024-01-02T03:04:05+00:00",
+		"author":  "Some One <someone@example.com>",
+	}
+	for k, v := range expected {
+		if got := d.Get(k).(string); got != v {
+			t.Errorf("expected %s to be %q, got %q", k, v, got)
+		}
+	}
+
+	if got := fmt.Sprint(d.Get("parents")); got != "[def456 fed654]" {
+		t.Errorf("expected parents [def456 fed654], got %s", got)
+	}
+}
+
+func TestDataReadRepositoryCommit_unknownRevision(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"type":"error","error":{"message":"Commit not found"}}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, dataRepositoryCommit().Schema, map[string]interface{}{
+		"workspace":  "example",
+		"repository": "repo",
+		"revision":   "missing",
+	})
+
+	diags := dataReadRepositoryCommit(context.Background(), d, Clients{httpClient: client})
+	if !diags.HasError() {
+		t.Fatal("expected an error for an unknown revision")
+	}
+
+	if expected := `revision "missing" does not resolve to a commit in repository example/repo`; !strings.Contains(diags[0].Summary, expected) {
+		t.Errorf("expected error %q, got %q", expected, diags[0].Summary)
+	}
+}
+
+func testAccBitbucketRepositoryCommitConfig(workspace, repo, revision string) string {
+	return fmt.Sprintf(`
+data "bitbucket_repository" "test" {
+  workspace = %[1]q
+  slug      = %[2]q
+}
+
+data "bitbucket_repository_commit" "test" {
+  workspace  = %[1]q
+  repository = %[2]q
+  revision   = %[3]s
+}
+`, workspace, repo, revision)
+}
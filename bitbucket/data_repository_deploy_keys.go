@@ -0,0 +1,94 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DeployKey is an SSH key with access to a repository
+type DeployKey struct {
+	SshKey
+	LastUsed string `json:"last_used,omitempty"`
+}
+
+func dataRepositoryDeployKeys() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadRepositoryDeployKeys,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"deploy_keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_used": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataReadRepositoryDeployKeys(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repoSlug := d.Get("repository").(string)
+
+	var deployKeys []interface{}
+	err := client.GetPaged(fmt.Sprintf("2.0/repositories/%s/%s/deploy-keys", workspace, repoSlug), func(value json.RawMessage) error {
+		var deployKey DeployKey
+		if err := json.Unmarshal(value, &deployKey); err != nil {
+			return err
+		}
+
+		deployKeys = append(deployKeys, map[string]interface{}{
+			"id":        deployKey.ID,
+			"label":     deployKey.Label,
+			"key":       deployKey.Key,
+			"comment":   deployKey.Comment,
+			"last_used": deployKey.LastUsed,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, repoSlug))
+	d.Set("deploy_keys", deployKeys)
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceRepositoryDeployKeys_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_repository_deploy_keys.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+	publicKey, _, err := RandSSHKeyPairSize(2048, "test@example.com")
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryDeployKeysConfig(workspace, rName, publicKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "deploy_keys.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "deploy_keys.0.id", "bitbucket_deploy_key.test", "key_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "deploy_keys.0.label", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "deploy_keys.0.comment", "test@example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "deploy_keys.0.last_used", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadRepositoryDeployKeys_paginated(t *testing.T) {
+	client := testClient(t, testPagedHandler(t,
+		`[{"id":1,"label":"ci","key":"ssh-ed25519 AAAA1","comment":"ci@example.com","last_used":"2024-01-02T03:04:05.000000+00:00"},{"id":2,"label":"backup","key":"ssh-ed25519 AAAA2","last_used":null}]`,
+		`[{"id":3,"label":"legacy","key":"ssh-rsa AAAA3","comment":"old"}]`,
+	))
+
+	d := schema.TestResourceDataRaw(t, dataRepositoryDeployKeys().Schema, map[string]interface{}{
+		"workspace":  "example",
+		"repository": "repo",
+	})
+
+	if diags := dataReadRepositoryDeployKeys(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []map[string]interface{}{
+		{"id": 1, "label": "ci", "key": "ssh-ed25519 AAAA1", "comment": "ci@example.com", "last_used": "2024-01-02T03:04:05.000000+00:00"},
+		{"id": 2, "label": "backup", "key": "ssh-ed25519 AAAA2", "comment": "", "last_used": ""},
+		{"id": 3, "label": "legacy", "key": "ssh-rsa AAAA3", "comment": "old", "last_used": ""},
+	}
+
+	deployKeys := d.Get("deploy_keys").([]interface{})
+	if len(deployKeys) != len(expected) {
+		t.Fatalf("expected %d deploy keys, got %d", len(expected), len(deployKeys))
+	}
+
+	for i, deployKey := range deployKeys {
+		deployKey := deployKey.(map[string]interface{})
+		for k, v := range expected[i] {
+			if got := deployKey[k]; got != v {
+				t.Errorf("expected deploy key %d %s to be %v, got %v", i, k, v, got)
+			}
+		}
+	}
+}
+
+func testAccBitbucketRepositoryDeployKeysConfig(workspace, rName, pubkey string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_deploy_key" "test" {
+  workspace  = %[1]q
+  repository = bitbucket_repository.test.name
+  key        = %[3]q
+  label      = %[2]q
+}
+
+data "bitbucket_repository_deploy_keys" "test" {
+  workspace  = %[1]q
+  repository = bitbucket_repository.test.name
+
+  depends_on = [bitbucket_deploy_key.test]
+}
+`, workspace, rName, pubkey)
+}
@@ -0,0 +1,94 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceRepositoryGroupAccess_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_repository_group_access.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryGroupAccessConfig(workspace, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "groups.0.group_slug", "bitbucket_group.test", "slug"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.permission", "read"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadRepositoryGroupAccess_paginated(t *testing.T) {
+	client := testClient(t, testPagedHandler(t,
+		`[{"permission":"admin","group":{"slug":"admins"}},{"permission":"write","group":{"slug":"developers"}}]`,
+		`[{"permission":"read","group":{"slug":"readers"}}]`,
+	))
+
+	d := schema.TestResourceDataRaw(t, dataRepositoryGroupAccess().Schema, map[string]interface{}{
+		"workspace":  "example",
+		"repository": "repo",
+	})
+
+	if diags := dataReadRepositoryGroupAccess(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []map[string]interface{}{
+		{"group_slug": "admins", "permission": "admin"},
+		{"group_slug": "developers", "permission": "write"},
+		{"group_slug": "readers", "permission": "read"},
+	}
+
+	groups := d.Get("groups").([]interface{})
+	if len(groups) != len(expected) {
+		t.Fatalf("expected %d groups, got %d", len(expected), len(groups))
+	}
+
+	for i, group := range groups {
+		for k, v := range expected[i] {
+			if got := group.(map[string]interface{})[k]; got != v {
+				t.Errorf("expected group %d %s to be %v, got %v", i, k, v, got)
+			}
+		}
+	}
+}
+
+func testAccBitbucketRepositoryGroupAccessConfig(workspace, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_group" "test" {
+  workspace = %[1]q
+  name      = %[2]q
+}
+
+resource "bitbucket_repository_group_permission" "test" {
+  workspace  = %[1]q
+  repo_slug  = bitbucket_repository.test.name
+  group_slug = bitbucket_group.test.slug
+  permission = "read"
+}
+
+data "bitbucket_repository_group_access" "test" {
+  workspace  = %[1]q
+  repository = bitbucket_repository_group_permission.test.repo_slug
+}
+`, workspace, rName)
+}
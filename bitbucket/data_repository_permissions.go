@@ -0,0 +1,118 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataRepositoryPermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadRepositoryPermissions,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permission": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permission": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataReadRepositoryPermissions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+
+	users := make([]interface{}, 0)
+	err := client.GetPaged(fmt.Sprintf("2.0/repositories/%s/%s/permissions-config/users", workspace, repo), func(value json.RawMessage) error {
+		var permission RepositoryUserPermission
+		if err := json.Unmarshal(value, &permission); err != nil {
+			return err
+		}
+
+		uuid := ""
+		if permission.User != nil {
+			uuid = permission.User.UUID
+		}
+
+		users = append(users, map[string]interface{}{
+			"uuid":       uuid,
+			"permission": permission.Permission,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groups := make([]interface{}, 0)
+	err = client.GetPaged(fmt.Sprintf("2.0/repositories/%s/%s/permissions-config/groups", workspace, repo), func(value json.RawMessage) error {
+		var permission RepositoryGroupPermission
+		if err := json.Unmarshal(value, &permission); err != nil {
+			return err
+		}
+
+		slug := ""
+		if permission.Group != nil {
+			slug = permission.Group.Slug
+		}
+
+		groups = append(groups, map[string]interface{}{
+			"slug":       slug,
+			"permission": permission.Permission,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, repo))
+	d.Set("users", users)
+	d.Set("groups", groups)
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceRepositoryPermissions_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_repository_permissions.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryPermissionsConfig(workspace, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "groups.*", map[string]string{
+						"slug":       rName,
+						"permission": "write",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadRepositoryPermissions(t *testing.T) {
+	for name, tc := range map[string]struct {
+		users  []string
+		groups []string
+
+		expectedUsers  []map[string]interface{}
+		expectedGroups []map[string]interface{}
+	}{
+		"empty": {
+			users:  []string{`[]`},
+			groups: []string{`[]`},
+		},
+		"populated": {
+			users: []string{
+				`[{"permission":"admin","user":{"uuid":"{1}"}},{"permission":"write","user":{"uuid":"{2}"}}]`,
+				`[{"permission":"read","user":{"uuid":"{3}"}}]`,
+			},
+			groups: []string{
+				`[{"permission":"write","group":{"slug":"developers"}}]`,
+				`[{"permission":"read","group":{"slug":"readers"}}]`,
+			},
+			expectedUsers: []map[string]interface{}{
+				{"uuid": "{1}", "permission": "admin"},
+				{"uuid": "{2}", "permission": "write"},
+				{"uuid": "{3}", "permission": "read"},
+			},
+			expectedGroups: []map[string]interface{}{
+				{"slug": "developers", "permission": "write"},
+				{"slug": "readers", "permission": "read"},
+			},
+		},
+	} {
+		mux := http.NewServeMux()
+		mux.Handle("/2.0/repositories/example/repo/permissions-config/users", testPagedHandler(t, tc.users...))
+		mux.Handle("/2.0/repositories/example/repo/permissions-config/groups", testPagedHandler(t, tc.groups...))
+		client := testClient(t, mux.ServeHTTP)
+
+		d := schema.TestResourceDataRaw(t, dataRepositoryPermissions().Schema, map[string]interface{}{
+			"workspace":  "example",
+			"repository": "repo",
+		})
+
+		if diags := dataReadRepositoryPermissions(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+			t.Fatalf("%s: unexpected error: %v", name, diags)
+		}
+
+		for attr, expected := range map[string][]map[string]interface{}{
+			"users":  tc.expectedUsers,
+			"groups": tc.expectedGroups,
+		} {
+			actual := d.Get(attr).([]interface{})
+			if len(actual) != len(expected) {
+				t.Fatalf("%s: expected %d %s, got %d", name, len(expected), attr, len(actual))
+			}
+
+			for i, item := range actual {
+				item := item.(map[string]interface{})
+				for k, v := range expected[i] {
+					if got := item[k]; got != v {
+						t.Errorf("%s: expected %s %d %s to be %v, got %v", name, attr, i, k, v, got)
+					}
+				}
+			}
+		}
+	}
+}
+
+func testAccBitbucketRepositoryPermissionsConfig(workspace, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_group" "test" {
+  workspace = %[1]q
+  name      = %[2]q
+}
+
+resource "bitbucket_repository_group_permission" "test" {
+  workspace  = %[1]q
+  repo_slug  = bitbucket_repository.test.name
+  group_slug = bitbucket_group.test.slug
+  permission = "write"
+}
+
+data "bitbucket_repository_permissions" "test" {
+  workspace  = %[1]q
+  repository = bitbucket_repository.test.name
+
+  depends_on = [bitbucket_repository_group_permission.test]
+}
+`, workspace, rName)
+}
@@ -0,0 +1,136 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/DrFaust92/bitbucket-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// PullRequestSummary is a pull request as listed for a repository
+type PullRequestSummary struct {
+	PullRequest
+	Author    *bitbucket.User `json:"author,omitempty"`
+	CreatedOn string          `json:"created_on,omitempty"`
+}
+
+func dataRepositoryPullRequests() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadRepositoryPullRequests,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"OPEN", "MERGED", "DECLINED", "SUPERSEDED"}, false),
+			},
+			"pull_requests": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"author": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"author_uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_branch": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination_branch": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenPullRequestBranch(endpoint *PullRequestEndpoint) string {
+	if endpoint == nil || endpoint.Branch == nil {
+		return ""
+	}
+
+	return endpoint.Branch.Name
+}
+
+func dataReadRepositoryPullRequests(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repoSlug := d.Get("repository").(string)
+
+	params := url.Values{}
+	if v, ok := d.GetOk("state"); ok {
+		params.Set("state", v.(string))
+	}
+
+	var pullRequests []interface{}
+	err := client.GetPagedWithQuery(fmt.Sprintf("2.0/repositories/%s/%s/pullrequests", workspace, repoSlug), params, func(value json.RawMessage) error {
+		var pr PullRequestSummary
+		if err := json.Unmarshal(value, &pr); err != nil {
+			return err
+		}
+
+		author, authorUUID := "", ""
+		if pr.Author != nil {
+			author, authorUUID = pr.Author.DisplayName, pr.Author.Uuid
+		}
+
+		pullRequests = append(pullRequests, map[string]interface{}{
+			"id":                 pr.ID,
+			"title":              pr.Title,
+			"author":             author,
+			"author_uuid":        authorUUID,
+			"source_branch":      flattenPullRequestBranch(pr.Source),
+			"destination_branch": flattenPullRequestBranch(pr.Destination),
+			"state":              pr.State,
+			"created_on":         pr.CreatedOn,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, repoSlug))
+	d.Set("pull_requests", pullRequests)
+
+	return nil
+}
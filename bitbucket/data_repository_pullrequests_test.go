@@ -0,0 +1,104 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceRepositoryPullRequests_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_repository_pullrequests.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	repo := os.Getenv("BITBUCKET_PIPELINED_REPO")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckPipeSchedule(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryPullRequestsConfig(workspace, repo, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "pull_requests.*", map[string]string{
+						"title": rName + " listed",
+						"state": "OPEN",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "pull_requests.*.id", "bitbucket_pull_request.test", "pull_request_id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "pull_requests.*.author_uuid", "data.bitbucket_current_user.test", "uuid"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadRepositoryPullRequests_state(t *testing.T) {
+	pages := testPagedHandler(t,
+		`[{"id":3,"title":"Three","state":"MERGED","author":{"uuid":"{a}","display_name":"Alice"},"source":{"branch":{"name":"feature/three"}},"destination":{"branch":{"name":"main"}},"created_on":"2024-03-01T00:00:00.000000+00:00"},{"id":2,"title":"Two","state":"MERGED","author":{"uuid":"{b}","display_name":"Bob"},"source":{"branch":{"name":"feature/two"}},"destination":{"branch":{"name":"main"}},"created_on":"2024-02-01T00:00:00.000000+00:00"}]`,
+		`[{"id":1,"title":"One","state":"MERGED","source":{"branch":{"name":"feature/one"}},"destination":{"branch":{"name":"develop"}},"created_on":"2024-01-01T00:00:00.000000+00:00"}]`,
+	)
+
+	var state string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "" {
+			state = r.URL.Query().Get("state")
+		}
+		pages(w, r)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataRepositoryPullRequests().Schema, map[string]interface{}{
+		"workspace":  "example",
+		"repository": "repo",
+		"state":      "MERGED",
+	})
+
+	if diags := dataReadRepositoryPullRequests(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if state != "MERGED" {
+		t.Errorf("expected the state to be filtered server side, got state=%q", state)
+	}
+
+	expected := []map[string]interface{}{
+		{"id": 3, "title": "Three", "author": "Alice", "author_uuid": "{a}", "source_branch": "feature/three", "destination_branch": "main", "state": "MERGED", "created_on": "2024-03-01T00:00:00.000000+00:00"},
+		{"id": 2, "title": "Two", "author": "Bob", "author_uuid": "{b}", "source_branch": "feature/two", "destination_branch": "main", "state": "MERGED", "created_on": "2024-02-01T00:00:00.000000+00:00"},
+		{"id": 1, "title": "One", "author": "", "author_uuid": "", "source_branch": "feature/one", "destination_branch": "develop", "state": "MERGED", "created_on": "2024-01-01T00:00:00.000000+00:00"},
+	}
+
+	pullRequests := d.Get("pull_requests").([]interface{})
+	if len(pullRequests) != len(expected) {
+		t.Fatalf("expected %d pull requests, got %d", len(expected), len(pullRequests))
+	}
+
+	for i, pr := range pullRequests {
+		pr := pr.(map[string]interface{})
+		for k, v := range expected[i] {
+			if got := pr[k]; got != v {
+				t.Errorf("expected pull request %d %s to be %v, got %v", i, k, v, got)
+			}
+		}
+	}
+}
+
+func testAccBitbucketRepositoryPullRequestsConfig(workspace, repo, rName string) string {
+	return testAccBitbucketPullRequestConfig(workspace, repo, rName, "listed") + fmt.Sprintf(`
+data "bitbucket_current_user" "test" {}
+
+data "bitbucket_repository_pullrequests" "test" {
+  workspace  = %[1]q
+  repository = %[2]q
+  state      = "OPEN"
+
+  depends_on = [bitbucket_pull_request.test]
+}
+`, workspace, repo)
+}
@@ -0,0 +1,112 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataRepositoryTags() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadRepositoryTags,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"query": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sort": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_hash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataReadRepositoryTags(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repoSlug := d.Get("repository").(string)
+
+	params := url.Values{}
+	if v, ok := d.GetOk("query"); ok {
+		params.Set("q", v.(string))
+	}
+	if v, ok := d.GetOk("sort"); ok {
+		params.Set("sort", v.(string))
+	}
+
+	var tags []interface{}
+	err := client.GetPagedWithQuery(fmt.Sprintf("2.0/repositories/%s/%s/refs/tags", workspace, repoSlug), params, func(value json.RawMessage) error {
+		var tag RepositoryRef
+		if err := json.Unmarshal(value, &tag); err != nil {
+			return err
+		}
+
+		// Lightweight tags have no date of their own, the one of the
+		// commit is the closest there is.
+		targetHash, date := "", tag.Date
+		if tag.Target != nil {
+			targetHash = tag.Target.Hash
+			if date == "" {
+				date = tag.Target.Date
+			}
+		}
+
+		// git terminates tag messages with a newline.
+		tags = append(tags, map[string]interface{}{
+			"name":        tag.Name,
+			"target_hash": targetHash,
+			"message":     strings.TrimSuffix(tag.Message, "\n"),
+			"date":        date,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, repoSlug))
+	d.Set("tags", tags)
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceRepositoryTags_sort(t *testing.T) {
+	dataSourceName := "data.bitbucket_repository_tags.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	repo := os.Getenv("BITBUCKET_PIPELINED_REPO")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckPipeSchedule(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryTagsConfig(workspace, repo, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.0.name", rName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.0.target_hash", "bitbucket_tag.test", "hash"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.0.message", "release "+rName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "tags.0.date"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadRepositoryTags_sorted(t *testing.T) {
+	pages := testPagedHandler(t,
+		`[{"name":"v2.0.0","message":"release 2","date":"2024-03-01T00:00:00+00:00","target":{"hash":"ccc","date":"2024-02-28T00:00:00+00:00"}},{"name":"v1.1.0","target":{"hash":"bbb","date":"2024-02-01T00:00:00+00:00"}}]`,
+		`[{"name":"v1.0.0","message":"release 1\n","date":"2024-01-01T00:00:00+00:00","target":{"hash":"aaa"}}]`,
+	)
+
+	var sort string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "" {
+			sort = r.URL.Query().Get("sort")
+		}
+		pages(w, r)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataRepositoryTags().Schema, map[string]interface{}{
+		"workspace":  "example",
+		"repository": "repo",
+		"sort":       "-target.date",
+	})
+
+	if diags := dataReadRepositoryTags(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if sort != "-target.date" {
+		t.Errorf("expected the tags to be sorted server side, got sort=%q", sort)
+	}
+
+	expected := []map[string]interface{}{
+		{"name": "v2.0.0", "target_hash": "ccc", "message": "release 2", "date": "2024-03-01T00:00:00+00:00"},
+		{"name": "v1.1.0", "target_hash": "bbb", "message": "", "date": "2024-02-01T00:00:00+00:00"},
+		{"name": "v1.0.0", "target_hash": "aaa", "message": "release 1", "date": "2024-01-01T00:00:00+00:00"},
+	}
+
+	tags := d.Get("tags").([]interface{})
+	if len(tags) != len(expected) {
+		t.Fatalf("expected %d tags, got %d", len(expected), len(tags))
+	}
+
+	for i, tag := range tags {
+		tag := tag.(map[string]interface{})
+		for k, v := range expected[i] {
+			if got := tag[k]; got != v {
+				t.Errorf("expected tag %d %s to be %v, got %v", i, k, v, got)
+			}
+		}
+	}
+}
+
+func testAccBitbucketRepositoryTagsConfig(workspace, repo, name string) string {
+	return testAccBitbucketTagConfig(workspace, repo, name) + fmt.Sprintf(`
+data "bitbucket_repository_tags" "test" {
+  workspace  = %[1]q
+  repository = %[2]q
+  query      = "name = \"${bitbucket_tag.test.name}\""
+  sort       = "-target.date"
+}
+`, workspace, repo)
+}
@@ -0,0 +1,53 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRepository_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_repository.test"
+	resourceName := "bitbucket_repository.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryDataConfig(workspace, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "workspace", workspace),
+					resource.TestCheckResourceAttrPair(dataSourceName, "slug", resourceName, "slug"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "uuid", resourceName, "uuid"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "scm", resourceName, "scm"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "is_private", resourceName, "is_private"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fork_policy", resourceName, "fork_policy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "project_key", resourceName, "project_key"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "clone_https", resourceName, "clone_https"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "clone_ssh", resourceName, "clone_ssh"),
+					resource.TestCheckResourceAttr(dataSourceName, "mainbranch", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccBitbucketRepositoryDataConfig(workspace, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+data "bitbucket_repository" "test" {
+  workspace = %[1]q
+  name      = bitbucket_repository.test.name
+}
+`, workspace, rName)
+}
@@ -0,0 +1,90 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/DrFaust92/bitbucket-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataRepositoryVariables() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadRepositoryVariables,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"variables": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secured": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataReadRepositoryVariables(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+
+	var variables []interface{}
+	err := client.GetPaged(fmt.Sprintf("2.0/repositories/%s/%s/pipelines_config/variables", workspace, repo), func(value json.RawMessage) error {
+		var variable bitbucket.PipelineVariable
+		if err := json.Unmarshal(value, &variable); err != nil {
+			return err
+		}
+
+		// Bitbucket doesn't return the value of secured variables, make
+		// sure it never ends up in state either.
+		if variable.Secured {
+			variable.Value = ""
+		}
+
+		variables = append(variables, map[string]interface{}{
+			"uuid":    variable.Uuid,
+			"key":     variable.Key,
+			"value":   variable.Value,
+			"secured": variable.Secured,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, repo))
+	d.Set("variables", variables)
+
+	return nil
+}
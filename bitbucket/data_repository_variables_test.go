@@ -0,0 +1,109 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceRepositoryVariables_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_repository_variables.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryVariablesConfig(workspace, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "variables.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "variables.*", map[string]string{
+						"key":     "plain",
+						"value":   "plain-value",
+						"secured": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "variables.*", map[string]string{
+						"key":     "secret",
+						"value":   "",
+						"secured": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadRepositoryVariables_paginated(t *testing.T) {
+	client := testClient(t, testPagedHandler(t,
+		`[{"uuid":"{1}","key":"one","value":"first","secured":false},{"uuid":"{2}","key":"two","secured":true}]`,
+		`[{"uuid":"{3}","key":"three","value":"third","secured":false}]`,
+	))
+
+	d := schema.TestResourceDataRaw(t, dataRepositoryVariables().Schema, map[string]interface{}{
+		"workspace":  "example",
+		"repository": "repo",
+	})
+
+	if diags := dataReadRepositoryVariables(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []map[string]interface{}{
+		{"uuid": "{1}", "key": "one", "value": "first", "secured": false},
+		{"uuid": "{2}", "key": "two", "value": "", "secured": true},
+		{"uuid": "{3}", "key": "three", "value": "third", "secured": false},
+	}
+
+	variables := d.Get("variables").([]interface{})
+	if len(variables) != len(expected) {
+		t.Fatalf("expected %d variables, got %d", len(expected), len(variables))
+	}
+
+	for i, variable := range variables {
+		variable := variable.(map[string]interface{})
+		for k, v := range expected[i] {
+			if got := variable[k]; got != v {
+				t.Errorf("expected variable %d %s to be %v, got %v", i, k, v, got)
+			}
+		}
+	}
+}
+
+func testAccBitbucketRepositoryVariablesConfig(workspace, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_repository_variable" "plain" {
+  key        = "plain"
+  value      = "plain-value"
+  repository = bitbucket_repository.test.id
+}
+
+resource "bitbucket_repository_variable" "secret" {
+  key        = "secret"
+  value      = "secret-value"
+  repository = bitbucket_repository.test.id
+  secured    = true
+}
+
+data "bitbucket_repository_variables" "test" {
+  workspace  = %[1]q
+  repository = bitbucket_repository.test.name
+
+  depends_on = [
+    bitbucket_repository_variable.plain,
+    bitbucket_repository_variable.secret,
+  ]
+}
+`, workspace, rName)
+}
@@ -0,0 +1,105 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceRepositoryWebhooks_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_repository_webhooks.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryWebhooksConfig(workspace, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "webhooks.0.uuid", "bitbucket_hook.test", "uuid"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks.0.url", "https://httpbin.org"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks.0.description", "Test hook for terraform"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks.0.active", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks.0.events.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "webhooks.0.events.*", "repo:push"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadRepositoryWebhooks_paginated(t *testing.T) {
+	client := testClient(t, testPagedHandler(t,
+		`[{"uuid":"{1}","url":"https://one.example.com","description":"one","active":true,"events":["repo:push"]},{"uuid":"{2}","url":"https://two.example.com","description":"two","active":false,"events":["repo:fork","repo:push"]}]`,
+		`[{"uuid":"{3}","url":"https://three.example.com","description":"three","active":true,"events":["pullrequest:created"]}]`,
+	))
+
+	d := schema.TestResourceDataRaw(t, dataRepositoryWebhooks().Schema, map[string]interface{}{
+		"workspace":  "example",
+		"repository": "repo",
+	})
+
+	if diags := dataReadRepositoryWebhooks(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []map[string]interface{}{
+		{"uuid": "{1}", "url": "https://one.example.com", "description": "one", "active": true},
+		{"uuid": "{2}", "url": "https://two.example.com", "description": "two", "active": false},
+		{"uuid": "{3}", "url": "https://three.example.com", "description": "three", "active": true},
+	}
+	expectedEvents := []int{1, 2, 1}
+
+	webhooks := d.Get("webhooks").([]interface{})
+	if len(webhooks) != len(expected) {
+		t.Fatalf("expected %d webhooks, got %d", len(expected), len(webhooks))
+	}
+
+	for i, webhook := range webhooks {
+		webhook := webhook.(map[string]interface{})
+		for k, v := range expected[i] {
+			if got := webhook[k]; got != v {
+				t.Errorf("expected webhook %d %s to be %v, got %v", i, k, v, got)
+			}
+		}
+
+		if got := webhook["events"].(*schema.Set).Len(); got != expectedEvents[i] {
+			t.Errorf("expected webhook %d to have %d events, got %d", i, expectedEvents[i], got)
+		}
+	}
+}
+
+func testAccBitbucketRepositoryWebhooksConfig(workspace, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_hook" "test" {
+  owner       = %[1]q
+  repository  = bitbucket_repository.test.name
+  description = "Test hook for terraform"
+  url         = "https://httpbin.org"
+
+  events = [
+    "repo:push",
+  ]
+}
+
+data "bitbucket_repository_webhooks" "test" {
+  workspace  = %[1]q
+  repository = bitbucket_repository.test.name
+
+  depends_on = [bitbucket_hook.test]
+}
+`, workspace, rName)
+}
@@ -2,8 +2,12 @@ package bitbucket
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net/http"
+	"net/url"
 
+	"github.com/DrFaust92/bitbucket-go-client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,35 +26,65 @@ func dataUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"nickname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"uuid": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"uuid", "account_id"},
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"uuid", "account_id"},
 			},
 		},
 	}
 }
 
 func dataReadUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-	usersApi := c.ApiClient.UsersApi
+	client := m.(Clients).httpClient
 	var selectedUser string
 
 	if v, ok := d.GetOk("uuid"); ok && v.(string) != "" {
 		selectedUser = v.(string)
+	} else if v, ok := d.GetOk("account_id"); ok && v.(string) != "" {
+		selectedUser = v.(string)
 	}
 
-	user, _, err := usersApi.UsersSelectedUserGet(c.AuthContext, selectedUser)
-	if err := handleClientError(err); err != nil {
+	user, err := getUser(client, fmt.Sprintf("2.0/users/%s", url.PathEscape(selectedUser)))
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] User: %#v", user)
 
-	d.SetId(user.Uuid)
+	// Users can hide parts of their profile, so any of these may be empty.
+	if user.Uuid != "" {
+		d.SetId(user.Uuid)
+	} else {
+		d.SetId(selectedUser)
+	}
 	d.Set("uuid", user.Uuid)
+	d.Set("account_id", user.AccountId)
 	d.Set("username", user.Username)
+	d.Set("nickname", user.Nickname)
 	d.Set("display_name", user.DisplayName)
 
 	return nil
 }
+
+// getUser fetches a user directly, the generated client decodes users into an
+// account model that lacks the account ID and nickname.
+func getUser(client *Client, endpoint string) (bitbucket.User, error) {
+	var user bitbucket.User
+	err := client.DoAndDecode(http.MethodGet, endpoint, nil, &user)
+
+	return user, err
+}
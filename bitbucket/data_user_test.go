@@ -19,6 +19,27 @@ func TestAccDataSourceUser_uuid(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, "uuid", currUserDataSource, "uuid"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "display_name", currUserDataSource, "display_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "account_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceUser_accountId(t *testing.T) {
+	dataSourceName := "data.bitbucket_user.test"
+	uuidDataSourceName := "data.bitbucket_user.by_uuid"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketUserAccountIdConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "uuid", uuidDataSourceName, "uuid"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_id", uuidDataSourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "nickname", uuidDataSourceName, "nickname"),
 				),
 			},
 		},
@@ -34,3 +55,17 @@ data "bitbucket_user" "test" {
 }
 `
 }
+
+func testAccBitbucketUserAccountIdConfig() string {
+	return `
+data "bitbucket_current_user" "test" {}
+
+data "bitbucket_user" "by_uuid" {
+  uuid = data.bitbucket_current_user.test.uuid
+}
+
+data "bitbucket_user" "test" {
+  account_id = data.bitbucket_user.by_uuid.account_id
+}
+`
+}
@@ -2,6 +2,7 @@ package bitbucket
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,6 +17,10 @@ func dataWorkspace() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"slug": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -28,6 +33,10 @@ func dataWorkspace() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"created_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -45,9 +54,13 @@ func dataReadWorkspace(ctx context.Context, d *schema.ResourceData, m interface{
 
 	d.SetId(workspaceReq.Uuid)
 	d.Set("workspace", workspace)
+	d.Set("uuid", workspaceReq.Uuid)
 	d.Set("name", workspaceReq.Name)
 	d.Set("slug", workspaceReq.Slug)
 	d.Set("is_private", workspaceReq.IsPrivate)
+	if !workspaceReq.CreatedOn.IsZero() {
+		d.Set("created_on", workspaceReq.CreatedOn.Format(time.RFC3339))
+	}
 
 	return nil
 }
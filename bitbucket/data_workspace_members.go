@@ -4,12 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/DrFaust92/bitbucket-go-client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// WorkspaceMember is a membership as returned by the workspace members
+// endpoint.
+type WorkspaceMember struct {
+	User bitbucket.User `json:"user"`
+}
+
 func dataWorkspaceMembers() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataReadWorkspaceMembers,
@@ -19,11 +26,39 @@ func dataWorkspaceMembers() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"members": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Computed: true,
 			},
+			"workspace_members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nickname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -32,44 +67,39 @@ func dataReadWorkspaceMembers(ctx context.Context, d *schema.ResourceData, m int
 	client := m.(Clients).httpClient
 
 	workspace := d.Get("workspace").(string)
-	resourceURL := fmt.Sprintf("2.0/workspaces/%s/members", workspace)
-
-	_, err := client.Get(resourceURL)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	filter := strings.ToLower(d.Get("filter").(string))
 
-	var paginatedMemberships bitbucket.PaginatedWorkspaceMemberships
 	var members []string
+	var workspaceMembers []interface{}
 
-	for {
-		membersRes, err := client.Get(resourceURL)
-		if err != nil {
-			return diag.FromErr(err)
+	err := client.GetPaged(fmt.Sprintf("2.0/workspaces/%s/members", workspace), func(value json.RawMessage) error {
+		var member WorkspaceMember
+		if err := json.Unmarshal(value, &member); err != nil {
+			return err
 		}
 
-		decoder := json.NewDecoder(membersRes.Body)
-		err = decoder.Decode(&paginatedMemberships)
-		if err != nil {
-			return diag.FromErr(err)
+		if filter != "" && !strings.Contains(strings.ToLower(member.User.Nickname), filter) {
+			return nil
 		}
 
-		for _, member := range paginatedMemberships.Values {
-			members = append(members, member.User.Uuid)
-		}
+		members = append(members, member.User.Uuid)
+		workspaceMembers = append(workspaceMembers, map[string]interface{}{
+			"uuid":         member.User.Uuid,
+			"account_id":   member.User.AccountId,
+			"nickname":     member.User.Nickname,
+			"display_name": member.User.DisplayName,
+		})
 
-		if paginatedMemberships.Next != "" {
-			nextPage := paginatedMemberships.Page + 1
-			resourceURL = fmt.Sprintf("2.0/workspaces/%s/members?page=%d", workspace, nextPage)
-			paginatedMemberships = bitbucket.PaginatedWorkspaceMemberships{}
-		} else {
-			break
-		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(workspace)
 	d.Set("workspace", workspace)
 	d.Set("members", members)
+	d.Set("workspace_members", workspaceMembers)
 
 	return nil
 }
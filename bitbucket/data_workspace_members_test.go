@@ -1,11 +1,13 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccDataSourceWorkspaceMembers_basic(t *testing.T) {
@@ -19,6 +21,7 @@ func TestAccDataSourceWorkspaceMembers_basic(t *testing.T) {
 				Config: testAccBitbucketWorkspaceMembersConfig(workspace),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(dataSourceName, "members.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "workspace_members.0.uuid"),
 				),
 			},
 		},
@@ -32,3 +35,39 @@ data "bitbucket_workspace_members" "test" {
 }
 `, workspace)
 }
+
+func TestDataReadWorkspaceMembers_paginated(t *testing.T) {
+	client := testClient(t, testPagedHandler(t,
+		`[{"user":{"uuid":"{1}","account_id":"a1","nickname":"alice","display_name":"Alice"}},{"user":{"uuid":"{2}","account_id":"a2","nickname":"bob","display_name":"Bob"}}]`,
+		`[{"user":{"uuid":"{3}","account_id":"a3","nickname":"Alicia","display_name":"Alicia"}}]`,
+	))
+
+	for filter, expected := range map[string][]string{
+		"":    {"{1}", "{2}", "{3}"},
+		"ali": {"{1}", "{3}"},
+	} {
+		d := schema.TestResourceDataRaw(t, dataWorkspaceMembers().Schema, map[string]interface{}{
+			"workspace": "example",
+			"filter":    filter,
+		})
+
+		if diags := dataReadWorkspaceMembers(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		members := d.Get("workspace_members").([]interface{})
+		if len(members) != len(expected) {
+			t.Fatalf("filter %q: expected %d members, got %d", filter, len(expected), len(members))
+		}
+
+		for i, uuid := range expected {
+			if got := members[i].(map[string]interface{})["uuid"]; got != uuid {
+				t.Errorf("filter %q: expected member %d to be %s, got %s", filter, i, uuid, got)
+			}
+		}
+
+		if got := d.Get("members").(*schema.Set).Len(); got != len(expected) {
+			t.Errorf("filter %q: expected %d member UUIDs, got %d", filter, len(expected), got)
+		}
+	}
+}
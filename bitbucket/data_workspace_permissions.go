@@ -0,0 +1,80 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/DrFaust92/bitbucket-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// WorkspacePermission is the role of a user in a workspace or project
+type WorkspacePermission struct {
+	Permission string         `json:"permission"`
+	User       bitbucket.User `json:"user"`
+}
+
+func dataWorkspacePermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadWorkspacePermissions,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"permissions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permission": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataReadWorkspacePermissions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+
+	var permissions []interface{}
+	err := client.GetPaged(fmt.Sprintf("2.0/workspaces/%s/permissions", workspace), func(value json.RawMessage) error {
+		var permission WorkspacePermission
+		if err := json.Unmarshal(value, &permission); err != nil {
+			return err
+		}
+
+		permissions = append(permissions, map[string]interface{}{
+			"user_uuid":  permission.User.Uuid,
+			"account_id": permission.User.AccountId,
+			"permission": permission.Permission,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(workspace)
+	d.Set("permissions", permissions)
+
+	return nil
+}
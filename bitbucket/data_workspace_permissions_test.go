@@ -0,0 +1,75 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceWorkspacePermissions_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_workspace_permissions.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketWorkspacePermissionsConfig(workspace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "permissions.#"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "permissions.*.user_uuid", "data.bitbucket_current_user.test", "uuid"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataReadWorkspacePermissions_paginated(t *testing.T) {
+	client := testClient(t, testPagedHandler(t,
+		`[{"permission":"owner","user":{"uuid":"{1}","account_id":"1:one"}},{"permission":"collaborator","user":{"uuid":"{2}","account_id":"2:two"}}]`,
+		`[{"permission":"member","user":{"uuid":"{3}","account_id":"3:three"}}]`,
+	))
+
+	d := schema.TestResourceDataRaw(t, dataWorkspacePermissions().Schema, map[string]interface{}{
+		"workspace": "example",
+	})
+
+	if diags := dataReadWorkspacePermissions(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []map[string]interface{}{
+		{"user_uuid": "{1}", "account_id": "1:one", "permission": "owner"},
+		{"user_uuid": "{2}", "account_id": "2:two", "permission": "collaborator"},
+		{"user_uuid": "{3}", "account_id": "3:three", "permission": "member"},
+	}
+
+	permissions := d.Get("permissions").([]interface{})
+	if len(permissions) != len(expected) {
+		t.Fatalf("expected %d permissions, got %d", len(expected), len(permissions))
+	}
+
+	for i, permission := range permissions {
+		permission := permission.(map[string]interface{})
+		for k, v := range expected[i] {
+			if got := permission[k]; got != v {
+				t.Errorf("expected permission %d %s to be %v, got %v", i, k, v, got)
+			}
+		}
+	}
+}
+
+func testAccBitbucketWorkspacePermissionsConfig(workspace string) string {
+	return fmt.Sprintf(`
+data "bitbucket_current_user" "test" {}
+
+data "bitbucket_workspace_permissions" "test" {
+  workspace = %[1]q
+}
+`, workspace)
+}
@@ -22,6 +22,8 @@ func TestAccDataSourceWorkspace_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "slug"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "is_private"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "uuid", dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "created_on"),
 				),
 			},
 		},
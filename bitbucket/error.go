@@ -11,10 +11,14 @@ func handleClientError(err error) error {
 	httpErr, ok := err.(bitbucket.GenericSwaggerError)
 	if ok {
 		var httpError bitbucket.ModelError
-		if err := json.Unmarshal(httpErr.Body(), &httpError); err != nil {
+		if err := json.Unmarshal(httpErr.Body(), &httpError); err != nil || httpError.Error_ == nil {
 			return fmt.Errorf(string(httpErr.Body()))
 		}
 
+		if httpError.Error_.Detail != "" {
+			return fmt.Errorf("%s: %s: %s", httpErr.Error(), httpError.Error_.Message, httpError.Error_.Detail)
+		}
+
 		return fmt.Errorf("%s: %s", httpErr.Error(), httpError.Error_.Message)
 	}
 
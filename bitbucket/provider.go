@@ -2,16 +2,26 @@ package bitbucket
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/DrFaust92/bitbucket-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	oauth2bitbucket "golang.org/x/oauth2/bitbucket"
-	oauth2clientcreds "golang.org/x/oauth2/clientcredentials"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/oauth2"
 )
 
+// ProviderVersion is the version of the provider reported in the User-Agent
+// header, it is set at build time with
+// -ldflags "-X github.com/terraform-providers/terraform-provider-bitbucket/bitbucket.ProviderVersion=x.y.z"
+var ProviderVersion = "dev"
+
 type ProviderConfig struct {
 	ApiClient   *bitbucket.APIClient
 	AuthContext context.Context
@@ -19,7 +29,7 @@ type ProviderConfig struct {
 
 type Clients struct {
 	genClient  ProviderConfig
-	httpClient Client
+	httpClient *Client
 }
 
 // Provider will create the necessary terraform provider to talk to the
@@ -65,54 +75,227 @@ func Provider() *schema.Provider {
 				DefaultFunc:   schema.EnvDefaultFunc("BITBUCKET_OAUTH_TOKEN", nil),
 				ConflictsWith: []string{"username", "password", "oauth_client_id", "oauth_client_secret"},
 			},
+			"base_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("BITBUCKET_BASE_URL", BitbucketEndpoint),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      DefaultMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_wait_min": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      DefaultRetryWaitMin.String(),
+				ValidateFunc: validateDuration,
+			},
+			"retry_wait_max": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      DefaultRetryWaitMax.String(),
+				ValidateFunc: validateDuration,
+			},
+			"request_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDuration,
+			},
+			"delete_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      DefaultDeleteConcurrency,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"skip_credentials_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BITBUCKET_SKIP_CREDENTIALS_VALIDATION", false),
+			},
 		},
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigureContext,
 		ResourcesMap: map[string]*schema.Resource{
-			"bitbucket_branch_restriction":          resourceBranchRestriction(),
-			"bitbucket_branching_model":             resourceBranchingModel(),
-			"bitbucket_default_reviewers":           resourceDefaultReviewers(),
-			"bitbucket_deploy_key":                  resourceDeployKey(),
-			"bitbucket_deployment":                  resourceDeployment(),
-			"bitbucket_deployment_variable":         resourceDeploymentVariable(),
-			"bitbucket_forked_repository":           resourceForkedRepository(),
-			"bitbucket_group":                       resourceGroup(),
-			"bitbucket_group_membership":            resourceGroupMembership(),
-			"bitbucket_hook":                        resourceHook(),
-			"bitbucket_pipeline_schedule":           resourcePipelineSchedule(),
-			"bitbucket_pipeline_ssh_key":            resourcePipelineSshKey(),
-			"bitbucket_pipeline_ssh_known_host":     resourcePipelineSshKnownHost(),
-			"bitbucket_project":                     resourceProject(),
-			"bitbucket_project_branching_model":     resourceProjectBranchingModel(),
-			"bitbucket_project_default_reviewers":   resourceProjectDefaultReviewers(),
-			"bitbucket_repository":                  resourceRepository(),
-			"bitbucket_repository_group_permission": resourceRepositoryGroupPermission(),
-			"bitbucket_repository_user_permission":  resourceRepositoryUserPermission(),
-			"bitbucket_repository_variable":         resourceRepositoryVariable(),
-			"bitbucket_ssh_key":                     resourceSshKey(),
-			"bitbucket_workspace_hook":              resourceWorkspaceHook(),
+			"bitbucket_branch":                       resourceBranch(),
+			"bitbucket_branch_restriction":           resourceBranchRestriction(),
+			"bitbucket_branching_model":              resourceBranchingModel(),
+			"bitbucket_commit_file":                  resourceCommitFile(),
+			"bitbucket_commit_status":                resourceCommitStatus(),
+			"bitbucket_default_reviewers":            resourceDefaultReviewers(),
+			"bitbucket_deploy_key":                   resourceDeployKey(),
+			"bitbucket_deployment":                   resourceDeployment(),
+			"bitbucket_deployment_variable":          resourceDeploymentVariable(),
+			"bitbucket_forked_repository":            resourceForkedRepository(),
+			"bitbucket_gpg_key":                      resourceGpgKey(),
+			"bitbucket_group":                        resourceGroup(),
+			"bitbucket_group_membership":             resourceGroupMembership(),
+			"bitbucket_hook":                         resourceHook(),
+			"bitbucket_pipeline_schedule":            resourcePipelineSchedule(),
+			"bitbucket_pipeline_ssh_key":             resourcePipelineSshKey(),
+			"bitbucket_pipeline_ssh_known_host":      resourcePipelineSshKnownHost(),
+			"bitbucket_project":                      resourceProject(),
+			"bitbucket_project_access_token":         resourceProjectAccessToken(),
+			"bitbucket_project_branching_model":      resourceProjectBranchingModel(),
+			"bitbucket_project_default_reviewers":    resourceProjectDefaultReviewers(),
+			"bitbucket_project_group_permission":     resourceProjectGroupPermission(),
+			"bitbucket_project_user_permission":      resourceProjectUserPermission(),
+			"bitbucket_pull_request":                 resourcePullRequest(),
+			"bitbucket_repository":                   resourceRepository(),
+			"bitbucket_repository_access_token":      resourceRepositoryAccessToken(),
+			"bitbucket_repository_fork":              resourceRepositoryFork(),
+			"bitbucket_repository_group_permission":  resourceRepositoryGroupPermission(),
+			"bitbucket_repository_group_permissions": resourceRepositoryGroupPermissions(),
+			"bitbucket_repository_inheritance_state": resourceRepositoryInheritanceState(),
+			"bitbucket_repository_pipeline_config":   resourceRepositoryPipelineConfig(),
+			"bitbucket_repository_user_permission":   resourceRepositoryUserPermission(),
+			"bitbucket_repository_variable":          resourceRepositoryVariable(),
+			"bitbucket_snippet":                      resourceSnippet(),
+			"bitbucket_ssh_key":                      resourceSshKey(),
+			"bitbucket_tag":                          resourceTag(),
+			"bitbucket_workspace_access_token":       resourceWorkspaceAccessToken(),
+			"bitbucket_workspace_hook":               resourceWorkspaceHook(),
+			"bitbucket_workspace_variable":           resourceWorkspaceVariable(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"bitbucket_current_user":              dataCurrentUser(),
-			"bitbucket_deployment":                dataDeployment(),
-			"bitbucket_group":                     dataGroup(),
-			"bitbucket_group_members":             dataGroupMembers(),
-			"bitbucket_groups":                    dataGroups(),
-			"bitbucket_hook_types":                dataHookTypes(),
-			"bitbucket_ip_ranges":                 dataIPRanges(),
-			"bitbucket_pipeline_oidc_config":      dataPipelineOidcConfig(),
-			"bitbucket_pipeline_oidc_config_keys": dataPipelineOidcConfigKeys(),
-			"bitbucket_user":                      dataUser(),
-			"bitbucket_workspace":                 dataWorkspace(),
-			"bitbucket_workspace_members":         dataWorkspaceMembers(),
+			"bitbucket_branch_restrictions":        dataBranchRestrictions(),
+			"bitbucket_current_user":               dataCurrentUser(),
+			"bitbucket_default_reviewers":          dataDefaultReviewers(),
+			"bitbucket_deployment":                 dataDeployment(),
+			"bitbucket_deployment_environments":    dataDeploymentEnvironments(),
+			"bitbucket_group":                      dataGroup(),
+			"bitbucket_group_members":              dataGroupMembers(),
+			"bitbucket_groups":                     dataGroups(),
+			"bitbucket_hook_types":                 dataHookTypes(),
+			"bitbucket_ip_ranges":                  dataIPRanges(),
+			"bitbucket_pipeline_oidc_config":       dataPipelineOidcConfig(),
+			"bitbucket_pipeline_oidc_config_keys":  dataPipelineOidcConfigKeys(),
+			"bitbucket_project":                    dataProject(),
+			"bitbucket_project_groups":             dataProjectGroups(),
+			"bitbucket_project_users":              dataProjectUsers(),
+			"bitbucket_projects":                   dataProjects(),
+			"bitbucket_repositories":               dataRepositories(),
+			"bitbucket_repository":                 dataRepository(),
+			"bitbucket_repository_branches":        dataRepositoryBranches(),
+			"bitbucket_repository_commit":          dataRepositoryCommit(),
+			"bitbucket_repository_commit_statuses": dataRepositoryCommitStatuses(),
+			"bitbucket_repository_deploy_keys":     dataRepositoryDeployKeys(),
+			"bitbucket_repository_group_access":    dataRepositoryGroupAccess(),
+			"bitbucket_repository_permissions":     dataRepositoryPermissions(),
+			"bitbucket_repository_pullrequests":    dataRepositoryPullRequests(),
+			"bitbucket_repository_tags":            dataRepositoryTags(),
+			"bitbucket_repository_variables":       dataRepositoryVariables(),
+			"bitbucket_repository_webhooks":        dataRepositoryWebhooks(),
+			"bitbucket_user":                       dataUser(),
+			"bitbucket_workspace":                  dataWorkspace(),
+			"bitbucket_workspace_members":          dataWorkspaceMembers(),
+			"bitbucket_workspace_permissions":      dataWorkspacePermissions(),
 		},
 	}
 }
 
+// providerConfigureContext configures the clients and checks the credentials
+// work, so a typo in a password fails the plan right away instead of the first
+// resource with a 401.
+func providerConfigureContext(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	meta, err := providerConfigure(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if d.Get("skip_credentials_validation").(bool) {
+		return meta, nil
+	}
+
+	// Anonymous access is fine for public data, there is nothing to check.
+	methods := authMethods(d)
+	if len(methods) == 0 {
+		return meta, nil
+	}
+
+	return meta, checkCredentials(meta.(Clients).httpClient, methods[0])
+}
+
+// checkCredentials makes a cheap authenticated request and turns a failure
+// into a diagnostic naming the authentication method in use.
+func checkCredentials(client *Client, method string) diag.Diagnostics {
+	res, err := client.Get("2.0/user")
+	if err == nil {
+		res.Body.Close()
+		return nil
+	}
+
+	invalid := diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "Invalid Bitbucket credentials",
+		Detail: fmt.Sprintf("Bitbucket rejected the %s credentials of the provider: %s. "+
+			"Check they are correct and haven't expired or been revoked, or set skip_credentials_validation "+
+			"for credentials that can't read the current user.", method, err),
+	}}
+
+	// Client credentials are exchanged for a token before the request is
+	// sent, wrong ones fail there.
+	var tokenError *oauth2.RetrieveError
+	if errors.As(err, &tokenError) {
+		return invalid
+	}
+
+	var apiError Error
+	if !errors.As(err, &apiError) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable to connect to Bitbucket",
+			Detail:   fmt.Sprintf("Verifying the %s credentials against %s failed: %s", method, client.BaseURL, err),
+		}}
+	}
+
+	switch apiError.StatusCode {
+	case http.StatusUnauthorized:
+		return invalid
+	case http.StatusForbidden:
+		// The credentials are valid but lack the account scope, resources
+		// may still work with the scopes they have.
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Unable to verify Bitbucket credentials",
+			Detail:   fmt.Sprintf("The %s credentials of the provider aren't allowed to read the current user: %s", method, apiError.APIError.Message),
+		}}
+	}
+
+	return diag.Errorf("verifying the %s credentials of the provider failed: %s", method, err)
+}
+
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	authCtx := context.Background()
 
 	client := &Client{
-		HTTPClient: &http.Client{},
+		HTTPClient:   NewHTTPClient(nil),
+		BaseURL:      BitbucketEndpoint,
+		UserAgent:    userAgent(),
+		MaxRetries:   DefaultMaxRetries,
+		RetryWaitMin: DefaultRetryWaitMin,
+		RetryWaitMax: DefaultRetryWaitMax,
+	}
+
+	if v, ok := d.GetOk("base_url"); ok {
+		baseURL, err := parseBaseURL(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		client.BaseURL = baseURL
+	}
+
+	if err := configureRetries(d, client); err != nil {
+		return nil, err
+	}
+
+	// Terraform destroys independent resources in parallel, this bounds how
+	// many of their deletes hit Bitbucket at once.
+	client.DeleteConcurrency = d.Get("delete_concurrency").(int)
+
+	if err := validateAuthMethods(d); err != nil {
+		return nil, err
 	}
 
 	if username, ok := d.GetOk("username"); ok {
@@ -146,19 +329,14 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			return nil, fmt.Errorf("found client ID for OAuth via Client Credentials Grant, but client secret was not specified")
 		}
 
-		config := &oauth2clientcreds.Config{
-			ClientID:     clientID.(string),
-			ClientSecret: clientSecret.(string),
-			TokenURL:     oauth2bitbucket.Endpoint.TokenURL,
-		}
-
-		tokenSource := config.TokenSource(authCtx)
-
-		client.OAuthTokenSource = tokenSource
+		tokenSource := client.UseClientCredentials(authCtx, clientID.(string), clientSecret.(string))
 		authCtx = context.WithValue(authCtx, bitbucket.ContextOAuth2, tokenSource)
 	}
 
 	conf := bitbucket.NewConfiguration()
+	conf.BasePath = strings.TrimSuffix(client.BaseURL, "/") + "/2.0"
+	conf.UserAgent = client.UserAgent
+	conf.HTTPClient = client.HTTPClient
 	apiClient := ProviderConfig{
 		ApiClient:   bitbucket.NewAPIClient(conf),
 		AuthContext: authCtx,
@@ -166,8 +344,96 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	clients := Clients{
 		genClient:  apiClient,
-		httpClient: *client,
+		httpClient: client,
 	}
 
 	return clients, nil
 }
+
+// validateAuthMethods makes sure at most one way of authenticating is
+// configured. ConflictsWith only covers the provider block, the environment
+// variables could still mix several of them.
+func validateAuthMethods(d *schema.ResourceData) error {
+	if methods := authMethods(d); len(methods) > 1 {
+		return fmt.Errorf("only one authentication method can be configured, found %s", strings.Join(methods, ", "))
+	}
+
+	return nil
+}
+
+// authMethods lists the authentication methods configured, in the provider
+// block or through environment variables.
+func authMethods(d *schema.ResourceData) []string {
+	var methods []string
+
+	if _, ok := d.GetOk("username"); ok {
+		methods = append(methods, "username/password")
+	}
+
+	if _, ok := d.GetOk("oauth_token"); ok {
+		methods = append(methods, "oauth_token")
+	}
+
+	if _, ok := d.GetOk("oauth_client_id"); ok {
+		methods = append(methods, "oauth_client_id/oauth_client_secret")
+	}
+
+	return methods
+}
+
+// parseBaseURL checks the base URL is an absolute http(s) URL, the value of
+// BITBUCKET_BASE_URL doesn't go through the schema validation.
+func parseBaseURL(raw string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid base_url %q: %w", raw, err)
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("invalid base_url %q: expected an absolute http or https URL like %q", raw, BitbucketEndpoint)
+	}
+
+	return strings.TrimSuffix(u.String(), "/") + "/", nil
+}
+
+// configureRetries applies the retry and timeout settings of the provider
+// block, the defaults of the client are kept for anything not set.
+func configureRetries(d *schema.ResourceData, client *Client) error {
+	// Zero is a valid value that turns retries off, GetOk would skip it.
+	maxRetries := d.Get("max_retries").(int)
+	if maxRetries < 0 {
+		return fmt.Errorf("max_retries must not be negative, got %d", maxRetries)
+	}
+	client.MaxRetries = maxRetries
+
+	durations := map[string]*time.Duration{
+		"retry_wait_min":  &client.RetryWaitMin,
+		"retry_wait_max":  &client.RetryWaitMax,
+		"request_timeout": &client.Timeout,
+	}
+	for k, duration := range durations {
+		v, ok := d.GetOk(k)
+		if !ok || v.(string) == "" {
+			continue
+		}
+
+		parsed, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", k, err)
+		}
+		if parsed < 0 {
+			return fmt.Errorf("%s must not be negative, got %s", k, parsed)
+		}
+		*duration = parsed
+	}
+
+	if client.RetryWaitMin > client.RetryWaitMax {
+		return fmt.Errorf("retry_wait_min (%s) must not be greater than retry_wait_max (%s)", client.RetryWaitMin, client.RetryWaitMax)
+	}
+
+	return nil
+}
+
+func userAgent() string {
+	return fmt.Sprintf("terraform-provider-bitbucket/%s", ProviderVersion)
+}
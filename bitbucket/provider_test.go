@@ -1,10 +1,17 @@
 package bitbucket
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -46,3 +53,233 @@ func testAccPreCheckPipeSchedule(t *testing.T) {
 		t.Fatal("BITBUCKET_PIPELINED_REPO must be set for acceptence tests")
 	}
 }
+
+func TestProviderConfigure_retries(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"max_retries":     0,
+		"retry_wait_min":  "1s",
+		"retry_wait_max":  "1m",
+		"request_timeout": "30s",
+	})
+
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := meta.(Clients).httpClient
+	if client.MaxRetries != 0 {
+		t.Errorf("expected max retries to be 0, got %d", client.MaxRetries)
+	}
+	if client.RetryWaitMin != time.Second {
+		t.Errorf("expected retry wait min to be 1s, got %s", client.RetryWaitMin)
+	}
+	if client.RetryWaitMax != time.Minute {
+		t.Errorf("expected retry wait max to be 1m, got %s", client.RetryWaitMax)
+	}
+	if client.Timeout != 30*time.Second {
+		t.Errorf("expected request timeout to be 30s, got %s", client.Timeout)
+	}
+}
+
+func TestProviderConfigure_retryDefaults(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{})
+
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := meta.(Clients).httpClient
+	if client.MaxRetries != DefaultMaxRetries || client.RetryWaitMin != DefaultRetryWaitMin || client.RetryWaitMax != DefaultRetryWaitMax || client.Timeout != 0 {
+		t.Errorf("expected the default retry settings, got %d, %s, %s, %s", client.MaxRetries, client.RetryWaitMin, client.RetryWaitMax, client.Timeout)
+	}
+}
+
+func TestProviderValidate_invalidRetries(t *testing.T) {
+	for name, raw := range map[string]map[string]interface{}{
+		"negative max_retries":     {"max_retries": -1},
+		"malformed retry_wait_min": {"retry_wait_min": "soon"},
+		"negative request_timeout": {"request_timeout": "-1s"},
+	} {
+		diags := Provider().Validate(terraform.NewResourceConfigRaw(raw))
+		if !diags.HasError() {
+			t.Errorf("expected %s to be rejected", name)
+		}
+	}
+}
+
+func TestProviderConfigure_retryWaitOrder(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"retry_wait_min": "1m",
+		"retry_wait_max": "1s",
+	})
+
+	if _, err := providerConfigure(d); err == nil {
+		t.Fatal("expected retry_wait_min greater than retry_wait_max to be rejected")
+	}
+}
+
+func TestProviderConfigure_baseURL(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"base_url": "https://bitbucket.example.com/api",
+	})
+
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := meta.(Clients).httpClient
+	if expected := "https://bitbucket.example.com/api/"; client.BaseURL != expected {
+		t.Errorf("expected base URL %q, got %q", expected, client.BaseURL)
+	}
+}
+
+func TestProviderConfigure_invalidBaseURL(t *testing.T) {
+	for _, baseURL := range []string{"bitbucket.example.com", "ftp://bitbucket.example.com", "https://"} {
+		d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+			"base_url": baseURL,
+		})
+
+		if _, err := providerConfigure(d); err == nil {
+			t.Errorf("expected base URL %q to be rejected", baseURL)
+		}
+	}
+}
+
+// unsetAuthEnv keeps the credentials of the acceptance tests out of unit
+// tests of the provider configuration.
+func unsetAuthEnv(t *testing.T) {
+	for _, k := range []string{"BITBUCKET_USERNAME", "BITBUCKET_PASSWORD", "BITBUCKET_OAUTH_TOKEN", "BITBUCKET_OAUTH_CLIENT_ID", "BITBUCKET_OAUTH_CLIENT_SECRET"} {
+		t.Setenv(k, "")
+	}
+}
+
+func TestProviderConfigure_oauthClientCredentials(t *testing.T) {
+	unsetAuthEnv(t)
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"oauth_client_id":     "key",
+		"oauth_client_secret": "secret",
+	})
+
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := meta.(Clients).httpClient
+	if client.OAuthTokenSource == nil {
+		t.Error("expected the client credentials token source to be used")
+	}
+
+	if client.Username != nil || client.Password != nil || client.OAuthToken != nil {
+		t.Error("expected no other credentials to be set")
+	}
+}
+
+func TestProviderConfigure_conflictingAuthMethods(t *testing.T) {
+	unsetAuthEnv(t)
+	t.Setenv("BITBUCKET_OAUTH_TOKEN", "token")
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"oauth_client_id":     "key",
+		"oauth_client_secret": "secret",
+	})
+
+	if _, err := providerConfigure(d); err == nil {
+		t.Fatal("expected mixing oauth_token with client credentials to be rejected")
+	}
+}
+
+func TestProviderConfigureContext_unauthorized(t *testing.T) {
+	unsetAuthEnv(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2.0/user" {
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"base_url": server.URL,
+		"username": "user",
+		"password": "wrong",
+	})
+
+	_, diags := providerConfigureContext(context.Background(), d)
+	if !diags.HasError() {
+		t.Fatal("expected invalid credentials to be rejected")
+	}
+
+	if diags[0].Summary != "Invalid Bitbucket credentials" || !strings.Contains(diags[0].Detail, "username/password") {
+		t.Errorf("expected the error to name the auth method, got %q: %q", diags[0].Summary, diags[0].Detail)
+	}
+}
+
+func TestProviderConfigureContext_forbidden(t *testing.T) {
+	unsetAuthEnv(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"base_url":    server.URL,
+		"oauth_token": "token",
+	})
+
+	meta, diags := providerConfigureContext(context.Background(), d)
+	if diags.HasError() || len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a warning for credentials without the account scope, got %v", diags)
+	}
+
+	if meta == nil {
+		t.Error("expected the provider to be configured")
+	}
+}
+
+func TestProviderConfigureContext_unreachable(t *testing.T) {
+	unsetAuthEnv(t)
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"base_url":    server.URL,
+		"oauth_token": "token",
+		"max_retries": 0,
+	})
+
+	_, diags := providerConfigureContext(context.Background(), d)
+	if !diags.HasError() || diags[0].Summary != "Unable to connect to Bitbucket" {
+		t.Fatalf("expected a connection error, got %v", diags)
+	}
+}
+
+func TestProviderConfigureContext_skipped(t *testing.T) {
+	unsetAuthEnv(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	configs := []map[string]interface{}{
+		// anonymous
+		{"base_url": server.URL},
+		{"base_url": server.URL, "oauth_token": "token", "skip_credentials_validation": true},
+	}
+
+	for _, raw := range configs {
+		d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+
+		if _, diags := providerConfigureContext(context.Background(), d); diags.HasError() {
+			t.Errorf("unexpected error: %v", diags)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// RepositoryRef is a branch or tag of a repository.
+type RepositoryRef struct {
+	Name    string             `json:"name"`
+	Message string             `json:"message,omitempty"`
+	Date    string             `json:"date,omitempty"`
+	Target  *RepositoryRefHash `json:"target,omitempty"`
+}
+
+type RepositoryRefHash struct {
+	Hash string `json:"hash"`
+	Date string `json:"date,omitempty"`
+}
+
+func resourceBranch() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBranchCreate,
+		ReadWithoutTimeout:   resourceBranchRead,
+		DeleteWithoutTimeout: resourceBranchDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBranchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+	branch := &RepositoryRef{
+		Name: d.Get("name").(string),
+		Target: &RepositoryRefHash{
+			Hash: d.Get("target").(string),
+		},
+	}
+
+	bytedata, err := json.Marshal(branch)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.DoAndDecode(http.MethodPost, fmt.Sprintf("2.0/repositories/%s/%s/refs/branches", workspace, repo), bytes.NewBuffer(bytedata), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", workspace, repo, branch.Name))
+
+	return resourceBranchRead(ctx, d, m)
+}
+
+func resourceBranchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repo, name, err := repositoryRefId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var branch RepositoryRef
+	err = client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/repositories/%s/%s/refs/branches/%s", workspace, repo, name), nil, &branch)
+
+	if IsNotFound(err) {
+		log.Printf("[WARN] Branch (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("workspace", workspace)
+	d.Set("repository", repo)
+	d.Set("name", branch.Name)
+
+	if branch.Target != nil {
+		d.Set("hash", branch.Target.Hash)
+
+		// The target the branch was created from is not returned, fall back to
+		// the commit it points to, e.g. after an import.
+		if d.Get("target").(string) == "" {
+			d.Set("target", branch.Target.Hash)
+		}
+	}
+
+	return nil
+}
+
+func resourceBranchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repo, name, err := repositoryRefId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Delete(fmt.Sprintf("2.0/repositories/%s/%s/refs/branches/%s", workspace, repo, name))
+
+	return diag.FromErr(err)
+}
+
+// repositoryRefId splits at most three parts, ref names may contain slashes.
+func repositoryRefId(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/REPO-SLUG/REF-NAME", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
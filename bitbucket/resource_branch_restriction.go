@@ -1,15 +1,18 @@
 package bitbucket
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
-
 	"net/http"
 	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
-	"github.com/DrFaust92/bitbucket-go-client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,25 +20,76 @@ import (
 
 // BranchRestriction is the data we need to send to create a new branch restriction for the repository
 type BranchRestriction struct {
-	ID              int     `json:"id,omitempty"`
-	Kind            string  `json:"kind,omitempty"`
-	BranchMatchkind string  `json:"branch_match_kind,omitempty"`
-	BranchType      string  `json:"branch_type,omitempty"`
-	Pattern         string  `json:"pattern,omitempty"`
-	Value           int     `json:"value,omitempty"`
-	Users           []User  `json:"users,omitempty"`
-	Groups          []Group `json:"groups,omitempty"`
+	ID              int         `json:"id,omitempty"`
+	Kind            string      `json:"kind,omitempty"`
+	BranchMatchkind string      `json:"branch_match_kind,omitempty"`
+	BranchType      string      `json:"branch_type,omitempty"`
+	Pattern         string      `json:"pattern,omitempty"`
+	Value           *int        `json:"value,omitempty"`
+	Users           []User      `json:"users"`
+	Groups          []Group     `json:"groups"`
+	AccessKeys      []AccessKey `json:"access_keys"`
+}
+
+// numericValue is the value of the restriction, 0 for kinds without one.
+func (r BranchRestriction) numericValue() int {
+	if r.Value == nil {
+		return 0
+	}
+
+	return *r.Value
+}
+
+// branchRestrictionValueKinds maps the kinds of restriction that take a
+// numeric value to the smallest value Bitbucket accepts for them.
+var branchRestrictionValueKinds = map[string]int{
+	"require_approvals_to_merge":                  1,
+	"require_default_reviewer_approvals_to_merge": 1,
+	"require_passing_builds_to_merge":             1,
+	"require_commits_behind":                      0,
 }
 
 // User is just the user struct we want to use for BranchRestrictions
 type User struct {
-	Username string `json:"username,omitempty"`
+	Username  string `json:"username,omitempty"`
+	UUID      string `json:"uuid,omitempty"`
+	AccountID string `json:"account_id,omitempty"`
+}
+
+// accountIDPattern matches Atlassian Account IDs, either the current
+// 557058:<uuid> form or the older 24 character hex IDs.
+var accountIDPattern = regexp.MustCompile(`^(\d+:[0-9a-fA-F-]+|[0-9a-fA-F]{24})$`)
+
+// expandUser builds the user a configured value refers to, a UUID surrounded by
+// braces, an Atlassian Account ID or a username.
+func expandUser(user string) User {
+	switch {
+	case strings.HasPrefix(user, "{"):
+		return User{UUID: user}
+	case accountIDPattern.MatchString(user):
+		return User{AccountID: user}
+	default:
+		return User{Username: user}
+	}
+}
+
+// matches reports whether the configured user refers to this user, it can
+// either be a UUID, an Atlassian Account ID or a username.
+func (u User) matches(user string) bool {
+	// Bitbucket doesn't keep the case of UUIDs and usernames as configured.
+	return user != "" && (strings.EqualFold(user, u.UUID) || strings.EqualFold(user, u.AccountID) || strings.EqualFold(user, u.Username))
 }
 
 // Group is the group we want to add to a branch restriction
 type Group struct {
-	Slug  string `json:"slug,omitempty"`
-	Owner User   `json:"owner,omitempty"`
+	Slug     string `json:"slug,omitempty"`
+	FullSlug string `json:"full_slug,omitempty"`
+	Owner    *User  `json:"owner,omitempty"`
+}
+
+// AccessKey is a deploy key exempted from a branch restriction
+type AccessKey struct {
+	ID int `json:"id"`
 }
 
 func resourceBranchRestriction() *schema.Resource {
@@ -44,6 +98,7 @@ func resourceBranchRestriction() *schema.Resource {
 		ReadContext:   resourceBranchRestrictionsRead,
 		UpdateContext: resourceBranchRestrictionsUpdate,
 		DeleteContext: resourceBranchRestrictionsDelete,
+		CustomizeDiff: resourceBranchRestrictionCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), "/")
@@ -97,13 +152,18 @@ func resourceBranchRestriction() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"branching_model", "glob"}, false),
 			},
 			"pattern": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"branch_type"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.TrimSpace(old) == strings.TrimSpace(new)
+				},
 			},
 			"branch_type": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"feature", "bugfix", "release", "hotfix", "development", "production"}, false),
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"pattern"},
+				ValidateFunc:  validation.StringInSlice([]string{"feature", "bugfix", "release", "hotfix", "development", "production"}, false),
 			},
 			"users": {
 				Type:     schema.TypeSet,
@@ -127,53 +187,66 @@ func resourceBranchRestriction() *schema.Resource {
 				},
 				Optional: true,
 			},
+			"access_keys": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Set:      schema.HashString,
+			},
 
 			"value": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 		},
 	}
 }
 
-func createBranchRestriction(d *schema.ResourceData) *bitbucket.Branchrestriction {
-
-	users := make([]bitbucket.Account, 0, d.Get("users").(*schema.Set).Len())
-
+func createBranchRestriction(d *schema.ResourceData) (*BranchRestriction, error) {
+	users := make([]User, 0, d.Get("users").(*schema.Set).Len())
 	for _, item := range d.Get("users").(*schema.Set).List() {
-		account := bitbucket.Account{
-			Username: item.(string),
-		}
-
-		users = append(users, account)
+		users = append(users, expandUser(item.(string)))
 	}
 
-	groups := make([]bitbucket.Group, 0, d.Get("groups").(*schema.Set).Len())
-
+	groups := make([]Group, 0, d.Get("groups").(*schema.Set).Len())
 	for _, item := range d.Get("groups").(*schema.Set).List() {
 		m := item.(map[string]interface{})
 
-		account := &bitbucket.Account{
-			Username: m["owner"].(string),
-		}
+		groups = append(groups, Group{
+			Slug: m["slug"].(string),
+			Owner: &User{
+				Username: m["owner"].(string),
+			},
+		})
+	}
 
-		group := bitbucket.Group{
-			Owner: account,
-			Slug:  m["slug"].(string),
+	accessKeys := make([]AccessKey, 0, d.Get("access_keys").(*schema.Set).Len())
+	for _, item := range d.Get("access_keys").(*schema.Set).List() {
+		id, err := strconv.Atoi(item.(string))
+		if err != nil {
+			return nil, fmt.Errorf("access key ID (%q) must be numeric", item.(string))
 		}
 
-		groups = append(groups, group)
+		accessKeys = append(accessKeys, AccessKey{ID: id})
 	}
 
-	restict := &bitbucket.Branchrestriction{
-		Kind:   d.Get("kind").(string),
-		Value:  int32(d.Get("value").(int)),
-		Users:  users,
-		Groups: groups,
+	restict := &BranchRestriction{
+		Kind:       d.Get("kind").(string),
+		Users:      users,
+		Groups:     groups,
+		AccessKeys: accessKeys,
+	}
+
+	// A value of 0 is meaningful for some kinds, so it is sent whenever the
+	// kind takes one.
+	if _, ok := branchRestrictionValueKinds[restict.Kind]; ok {
+		value := d.Get("value").(int)
+		restict.Value = &value
 	}
 
 	if v, ok := d.GetOk("pattern"); ok {
-		restict.Pattern = v.(string)
+		restict.Pattern = strings.TrimSpace(v.(string))
 	}
 
 	if v, ok := d.GetOk("branch_type"); ok {
@@ -181,69 +254,305 @@ func createBranchRestriction(d *schema.ResourceData) *bitbucket.Branchrestrictio
 	}
 
 	if v, ok := d.GetOk("branch_match_kind"); ok {
-		restict.BranchMatchKind = v.(string)
+		restict.BranchMatchkind = v.(string)
 	}
 
-	return restict
+	return restict, nil
+}
+
+// validateBranchRestrictionMatcher checks the restriction targets branches the
+// way its branch_match_kind expects, Bitbucket only reports a generic error
+// otherwise.
+func validateBranchRestrictionMatcher(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("branch_match_kind") || !d.NewValueKnown("pattern") || !d.NewValueKnown("branch_type") {
+		return nil
+	}
+
+	switch d.Get("branch_match_kind").(string) {
+	case "glob":
+		if d.Get("pattern").(string) == "" {
+			return fmt.Errorf("pattern is required when branch_match_kind is glob")
+		}
+	case "branching_model":
+		if d.Get("branch_type").(string) == "" {
+			return fmt.Errorf("branch_type is required when branch_match_kind is branching_model")
+		}
+	}
+
+	return nil
+}
+
+// validateBranchRestrictionValue checks value is only set for the kinds that
+// take one and is in range for them, otherwise Bitbucket drops it and the
+// restriction never converges.
+func validateBranchRestrictionValue(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("kind") || !d.NewValueKnown("value") {
+		return nil
+	}
+
+	kind := d.Get("kind").(string)
+	value := d.Get("value").(int)
+
+	min, ok := branchRestrictionValueKinds[kind]
+	if !ok {
+		if value != 0 {
+			return fmt.Errorf("value is not supported by branch restrictions of kind %s", kind)
+		}
+
+		return nil
+	}
+
+	if value < min {
+		return fmt.Errorf("value has to be at least %d for branch restrictions of kind %s", min, kind)
+	}
+
+	return nil
+}
+
+// resourceBranchRestrictionCustomizeDiff rejects restrictions Bitbucket can't
+// store while planning, instead of halfway through an apply.
+func resourceBranchRestrictionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if err := validateBranchRestrictionMatcher(d); err != nil {
+		return err
+	}
+
+	return validateBranchRestrictionValue(d)
 }
 
 func resourceBranchRestrictionsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-	brApi := c.ApiClient.BranchRestrictionsApi
-	branchRestriction := createBranchRestriction(d)
+	client := m.(Clients).httpClient
+
+	branchRestriction, err := createBranchRestriction(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bytedata, err := json.Marshal(branchRestriction)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	repo := d.Get("repository").(string)
 	workspace := d.Get("owner").(string)
-	branchRestrictionReq, _, err := brApi.RepositoriesWorkspaceRepoSlugBranchRestrictionsPost(c.AuthContext, *branchRestriction, repo, workspace)
 
-	if err := handleClientError(err); err != nil {
+	// Bitbucket accepts duplicate restrictions, so a create that is retried
+	// after failing half way would leave the first one behind. Adopt a
+	// restriction of the same kind on the same branches instead.
+	existing, err := findBranchRestriction(client, workspace, repo, branchRestriction)
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(string(fmt.Sprintf("%v", branchRestrictionReq.Id)))
+	if existing != nil {
+		log.Printf("[WARN] Adopting existing Branch Restriction (%d) of kind %s in %s/%s, destroying this resource deletes it", existing.ID, existing.Kind, workspace, repo)
+		d.SetId(fmt.Sprintf("%d", existing.ID))
+
+		res, err := client.Put(fmt.Sprintf("2.0/repositories/%s/%s/branch-restrictions/%d", workspace, repo, existing.ID), bytes.NewBuffer(bytedata))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		res.Body.Close()
+
+		return resourceBranchRestrictionsRead(ctx, d, m)
+	}
+
+	var created BranchRestriction
+	err = client.DoAndDecode(http.MethodPost, fmt.Sprintf("2.0/repositories/%s/%s/branch-restrictions", workspace, repo), bytes.NewBuffer(bytedata), &created)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", created.ID))
 
 	return resourceBranchRestrictionsRead(ctx, d, m)
 }
 
+// findBranchRestriction looks for a restriction of the same kind that
+// applies to the same branches, nil if there is none.
+func findBranchRestriction(client *Client, workspace, repo string, want *BranchRestriction) (*BranchRestriction, error) {
+	var found *BranchRestriction
+
+	err := client.GetPagedWithQuery(fmt.Sprintf("2.0/repositories/%s/%s/branch-restrictions", workspace, repo), url.Values{"kind": {want.Kind}}, func(value json.RawMessage) error {
+		var restriction BranchRestriction
+		if err := json.Unmarshal(value, &restriction); err != nil {
+			return err
+		}
+
+		if found == nil && restriction.matches(want) {
+			found = &restriction
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+// matches reports whether both restrictions are of the same kind and target
+// the same branches.
+func (r BranchRestriction) matches(other *BranchRestriction) bool {
+	matchKind := func(r BranchRestriction) string {
+		if r.BranchMatchkind == "" {
+			return "glob"
+		}
+		return r.BranchMatchkind
+	}
+
+	if r.Kind != other.Kind || matchKind(r) != matchKind(*other) {
+		return false
+	}
+
+	if matchKind(r) == "branching_model" {
+		return r.BranchType == other.BranchType
+	}
+
+	return r.Pattern == other.Pattern
+}
+
 func resourceBranchRestrictionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-	brApi := c.ApiClient.BranchRestrictionsApi
+	client := m.(Clients).httpClient
 
-	brRes, res, err := brApi.RepositoriesWorkspaceRepoSlugBranchRestrictionsIdGet(c.AuthContext, url.PathEscape(d.Id()),
-		d.Get("repository").(string), d.Get("owner").(string))
+	var brRes BranchRestriction
+	err := client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/repositories/%s/%s/branch-restrictions/%s",
+		d.Get("owner").(string), d.Get("repository").(string), url.PathEscape(d.Id())), nil, &brRes)
 
-	if res != nil && res.StatusCode == http.StatusNotFound {
+	if IsNotFound(err) {
 		log.Printf("[WARN] Branch Restrictions (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	if err := handleClientError(err); err != nil {
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(string(fmt.Sprintf("%v", brRes.Id)))
+	d.SetId(fmt.Sprintf("%d", brRes.ID))
 	d.Set("kind", brRes.Kind)
-	d.Set("pattern", brRes.Pattern)
-	d.Set("value", brRes.Value)
-	d.Set("users", brRes.Users)
-	d.Set("groups", brRes.Groups)
-	d.Set("branch_type", brRes.BranchType)
-	d.Set("branch_match_kind", brRes.BranchMatchKind)
+	d.Set("value", brRes.numericValue())
+	d.Set("users", flattenBranchRestrictionUsers(brRes.Users, d.Get("users").(*schema.Set)))
+	d.Set("groups", flattenBranchRestrictionGroups(brRes.Groups, d.Get("groups").(*schema.Set)))
+	d.Set("access_keys", flattenBranchRestrictionAccessKeys(brRes.AccessKeys))
+
+	// Only the matcher of the reported kind is meaningful, the other one is
+	// cleared so switching between them doesn't leave stale values behind.
+	branchMatchKind := brRes.BranchMatchkind
+	if branchMatchKind == "" {
+		branchMatchKind = "glob"
+	}
+	d.Set("branch_match_kind", branchMatchKind)
+
+	if branchMatchKind == "branching_model" {
+		d.Set("branch_type", brRes.BranchType)
+		d.Set("pattern", "")
+	} else {
+		// Bitbucket trims the pattern, keep the configured one unless they
+		// actually differ.
+		if strings.TrimSpace(d.Get("pattern").(string)) != brRes.Pattern {
+			d.Set("pattern", brRes.Pattern)
+		}
+		d.Set("branch_type", "")
+	}
 
 	return nil
 }
 
+// flattenBranchRestrictionUsers keeps the configured form of every user
+// Bitbucket reports, a user can be configured by UUID, Account ID or username
+// but is always returned with all of them. Every user is listed once and the
+// result is sorted, so the order Bitbucket returns users in doesn't matter.
+func flattenBranchRestrictionUsers(users []User, configured *schema.Set) []string {
+	result := make([]string, 0, len(users))
+	seen := make(map[string]bool, len(users))
+
+	for _, user := range users {
+		value := user.UUID
+		for _, item := range configured.List() {
+			if user.matches(item.(string)) {
+				value = item.(string)
+				break
+			}
+		}
+
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+func flattenBranchRestrictionGroups(groups []Group, configured *schema.Set) []interface{} {
+	result := make([]interface{}, 0, len(groups))
+
+	for _, group := range groups {
+		owner := ""
+		if parts := strings.SplitN(group.FullSlug, ":", 2); len(parts) == 2 {
+			owner = parts[0]
+		} else if group.Owner != nil {
+			owner = group.Owner.Username
+		}
+
+		slug := group.Slug
+		for _, item := range configured.List() {
+			m := item.(map[string]interface{})
+			if strings.EqualFold(m["slug"].(string), group.Slug) {
+				owner = m["owner"].(string)
+				slug = m["slug"].(string)
+				break
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"owner": owner,
+			"slug":  slug,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i].(map[string]interface{}), result[j].(map[string]interface{})
+		if a["owner"] != b["owner"] {
+			return a["owner"].(string) < b["owner"].(string)
+		}
+		return a["slug"].(string) < b["slug"].(string)
+	})
+
+	return result
+}
+
+func flattenBranchRestrictionAccessKeys(accessKeys []AccessKey) []string {
+	result := make([]string, 0, len(accessKeys))
+
+	for _, accessKey := range accessKeys {
+		result = append(result, strconv.Itoa(accessKey.ID))
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
 func resourceBranchRestrictionsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-	brApi := c.ApiClient.BranchRestrictionsApi
-	branchRestriction := createBranchRestriction(d)
+	client := m.(Clients).httpClient
+
+	branchRestriction, err := createBranchRestriction(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	_, _, err := brApi.RepositoriesWorkspaceRepoSlugBranchRestrictionsIdPut(c.AuthContext,
-		*branchRestriction, url.PathEscape(d.Id()),
-		d.Get("repository").(string), d.Get("owner").(string))
+	bytedata, err := json.Marshal(branchRestriction)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	if err := handleClientError(err); err != nil {
+	_, err = client.Put(fmt.Sprintf("2.0/repositories/%s/%s/branch-restrictions/%s",
+		d.Get("owner").(string), d.Get("repository").(string), url.PathEscape(d.Id())), bytes.NewBuffer(bytedata))
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -251,20 +560,15 @@ func resourceBranchRestrictionsUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceBranchRestrictionsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-	brApi := c.ApiClient.BranchRestrictionsApi
+	client := m.(Clients).httpClient
 
-	res, err := brApi.RepositoriesWorkspaceRepoSlugBranchRestrictionsIdDelete(c.AuthContext, url.PathEscape(d.Id()),
-		d.Get("repository").(string), d.Get("owner").(string))
+	_, err := client.Delete(fmt.Sprintf("2.0/repositories/%s/%s/branch-restrictions/%s",
+		d.Get("owner").(string), d.Get("repository").(string), url.PathEscape(d.Id())))
 
-	if res != nil && res.StatusCode == http.StatusNotFound {
+	if IsNotFound(err) {
 		log.Printf("[WARN] Branch Restrictions (%s) not found, removing from state", d.Id())
 		return nil
 	}
 
-	if err := handleClientError(err); err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
+	return diag.FromErr(err)
 }
@@ -1,6 +1,8 @@
 package bitbucket
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -9,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -73,6 +76,41 @@ func TestAccBitbucketBranchRestriction_model(t *testing.T) {
 	})
 }
 
+func TestAccBitbucketBranchRestriction_approvals(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-test")
+	testUser := os.Getenv("BITBUCKET_TEAM")
+	resourceName := "bitbucket_branch_restriction.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketBranchRestrictionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketBranchRestrictionValueConfig(testUser, rName, "require_approvals_to_merge", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketBranchRestrictionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kind", "require_approvals_to_merge"),
+					resource.TestCheckResourceAttr(resourceName, "value", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccCheckBitbucketBranchRestrictionImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketBranchRestrictionValueConfig(testUser, rName, "require_approvals_to_merge", 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketBranchRestrictionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "3"),
+				),
+			},
+		},
+	})
+}
+
 func testAccBitbucketBranchRestrictionConfig(testUser, rName string) string {
 	return fmt.Sprintf(`
 resource "bitbucket_repository" "test" {
@@ -104,6 +142,22 @@ resource "bitbucket_branch_restriction" "test" {
 `, testUser, rName)
 }
 
+func testAccBitbucketBranchRestrictionValueConfig(testUser, rName, kind string, value int) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+resource "bitbucket_branch_restriction" "test" {
+  owner      = %[1]q
+  repository = bitbucket_repository.test.name
+  kind       = %[3]q
+  pattern    = "master"
+  value      = %[4]d
+}
+`, testUser, rName, kind, value)
+}
+
 func testAccCheckBitbucketBranchRestrictionDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(Clients).genClient
 	brApi := client.ApiClient.BranchRestrictionsApi
@@ -151,3 +205,377 @@ func testAccCheckBitbucketBranchRestrictionImportStateIdFunc(resourceName string
 		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["owner"], rs.Primary.Attributes["repository"], rs.Primary.ID), nil
 	}
 }
+
+func TestValidateBranchRestrictionMatcher(t *testing.T) {
+	cases := []struct {
+		raw   map[string]interface{}
+		valid bool
+	}{
+		{map[string]interface{}{"pattern": "main"}, true},
+		{map[string]interface{}{"branch_match_kind": "glob", "pattern": "release/*"}, true},
+		{map[string]interface{}{"branch_match_kind": "glob"}, false},
+		{map[string]interface{}{"branch_match_kind": "branching_model", "branch_type": "release"}, true},
+		{map[string]interface{}{"branch_match_kind": "branching_model"}, false},
+	}
+
+	for _, tc := range cases {
+		tc.raw["owner"] = "example"
+		tc.raw["repository"] = "repo"
+		tc.raw["kind"] = "push"
+
+		_, err := resourceBranchRestriction().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.raw), nil)
+		if tc.valid && err != nil {
+			t.Errorf("expected %v to be valid, got %s", tc.raw, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("expected %v to be invalid", tc.raw)
+		}
+	}
+}
+
+func TestResourceBranchRestrictionCustomizeDiff_unknown(t *testing.T) {
+	// the pattern comes from another resource and is only known on apply,
+	// Terraform passes such values as this placeholder
+	_, err := resourceBranchRestriction().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"owner":             "example",
+		"repository":        "repo",
+		"kind":              "push",
+		"branch_match_kind": "glob",
+		"pattern":           "74D93920-ED26-11E3-AC10-0800200C9A66",
+	}), nil)
+	if err != nil {
+		t.Errorf("expected an unknown pattern to be left for apply, got %s", err)
+	}
+}
+
+func TestCreateBranchRestriction_pushExemptions(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBranchRestriction().Schema, map[string]interface{}{
+		"owner":       "example",
+		"repository":  "repo",
+		"kind":        "push",
+		"pattern":     "main",
+		"users":       []interface{}{"{bot-uuid}"},
+		"groups":      []interface{}{map[string]interface{}{"owner": "example", "slug": "ci"}},
+		"access_keys": []interface{}{"42"},
+	})
+
+	restriction, err := createBranchRestriction(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	payload, err := json.Marshal(restriction)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"kind":"push","branch_match_kind":"glob","pattern":"main","users":[{"uuid":"{bot-uuid}"}],"groups":[{"slug":"ci","owner":{"username":"example"}}],"access_keys":[{"id":42}]}`
+	if string(payload) != expected {
+		t.Errorf("expected payload %s, got %s", expected, payload)
+	}
+}
+
+func TestResourceBranchRestrictionsRead_pushExemptions(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"id": 7,
+			"kind": "push",
+			"branch_match_kind": "glob",
+			"pattern": "main",
+			"users": [
+				{"uuid": "{second}", "account_id": "557058:second"},
+				{"uuid": "{first}", "account_id": "557058:first"}
+			],
+			"groups": [{"slug": "ci", "full_slug": "example:ci"}],
+			"access_keys": [{"id": 42}]
+		}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceBranchRestriction().Schema, map[string]interface{}{
+		"owner":      "example",
+		"repository": "repo",
+		"kind":       "push",
+		"pattern":    "main",
+		"users":      []interface{}{"{first}", "557058:second"},
+	})
+	d.SetId("7")
+
+	if diags := resourceBranchRestrictionsRead(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	users := d.Get("users").(*schema.Set)
+	if users.Len() != 2 || !users.Contains("{first}") || !users.Contains("557058:second") {
+		t.Errorf("expected the configured users to be kept, got %v", users.List())
+	}
+
+	groups := d.Get("groups").(*schema.Set).List()
+	if len(groups) != 1 || groups[0].(map[string]interface{})["owner"] != "example" || groups[0].(map[string]interface{})["slug"] != "ci" {
+		t.Errorf("unexpected groups %v", groups)
+	}
+
+	accessKeys := d.Get("access_keys").(*schema.Set)
+	if accessKeys.Len() != 1 || !accessKeys.Contains("42") {
+		t.Errorf("unexpected access keys %v", accessKeys.List())
+	}
+}
+
+func TestValidateBranchRestrictionValue(t *testing.T) {
+	cases := []struct {
+		kind  string
+		value int
+		valid bool
+	}{
+		{"require_approvals_to_merge", 2, true},
+		{"require_approvals_to_merge", 0, false},
+		{"require_passing_builds_to_merge", 1, true},
+		{"require_commits_behind", 0, true},
+		{"push", 0, true},
+		{"push", 2, false},
+	}
+
+	for _, tc := range cases {
+		_, err := resourceBranchRestriction().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"owner":      "example",
+			"repository": "repo",
+			"kind":       tc.kind,
+			"pattern":    "main",
+			"value":      tc.value,
+		}), nil)
+		if tc.valid && err != nil {
+			t.Errorf("expected %s with value %d to be valid, got %s", tc.kind, tc.value, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("expected %s with value %d to be invalid", tc.kind, tc.value)
+		}
+	}
+}
+
+func TestCreateBranchRestriction_value(t *testing.T) {
+	cases := []struct {
+		kind     string
+		value    int
+		expected string
+	}{
+		{"require_approvals_to_merge", 2, `{"kind":"require_approvals_to_merge","branch_match_kind":"glob","pattern":"main","value":2,"users":[],"groups":[],"access_keys":[]}`},
+		{"require_commits_behind", 0, `{"kind":"require_commits_behind","branch_match_kind":"glob","pattern":"main","value":0,"users":[],"groups":[],"access_keys":[]}`},
+		{"enforce_merge_checks", 0, `{"kind":"enforce_merge_checks","branch_match_kind":"glob","pattern":"main","users":[],"groups":[],"access_keys":[]}`},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceBranchRestriction().Schema, map[string]interface{}{
+			"owner":      "example",
+			"repository": "repo",
+			"kind":       tc.kind,
+			"pattern":    "main",
+			"value":      tc.value,
+		})
+
+		restriction, err := createBranchRestriction(d)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		payload, err := json.Marshal(restriction)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if string(payload) != tc.expected {
+			t.Errorf("expected payload %s, got %s", tc.expected, payload)
+		}
+	}
+}
+
+func TestResourceBranchRestrictionsRead_value(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":8,"kind":"require_approvals_to_merge","branch_match_kind":"glob","pattern":"main","value":2,"users":[],"groups":[]}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceBranchRestriction().Schema, map[string]interface{}{
+		"owner":      "example",
+		"repository": "repo",
+		"kind":       "require_approvals_to_merge",
+		"pattern":    "main",
+	})
+	d.SetId("8")
+
+	if diags := resourceBranchRestrictionsRead(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if value := d.Get("value").(int); value != 2 {
+		t.Errorf("expected value 2, got %d", value)
+	}
+}
+
+func TestResourceBranchRestrictionsCreate_adoptsExisting(t *testing.T) {
+	var requests []string
+	var kind string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/2.0/repositories/example/repo/branch-restrictions":
+			kind = r.URL.Query().Get("kind")
+			fmt.Fprint(w, `{"values":[
+				{"id":8,"kind":"push","branch_match_kind":"glob","pattern":"develop"},
+				{"id":9,"kind":"push","branch_match_kind":"glob","pattern":"main"}
+			]}`)
+		case r.URL.Path == "/2.0/repositories/example/repo/branch-restrictions/9":
+			fmt.Fprint(w, `{"id":9,"kind":"push","branch_match_kind":"glob","pattern":"main","users":[{"uuid":"{bot}"}]}`)
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusTeapot)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceBranchRestriction().Schema, map[string]interface{}{
+		"owner":      "example",
+		"repository": "repo",
+		"kind":       "push",
+		"pattern":    "main",
+		"users":      []interface{}{"{bot}"},
+	})
+
+	if diags := resourceBranchRestrictionsCreate(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if kind != "push" {
+		t.Errorf("expected the restrictions to be filtered by kind, got kind=%q", kind)
+	}
+
+	if d.Id() != "9" {
+		t.Errorf("expected the existing restriction to be adopted, got ID %q", d.Id())
+	}
+
+	expected := []string{
+		"GET /2.0/repositories/example/repo/branch-restrictions",
+		"PUT /2.0/repositories/example/repo/branch-restrictions/9",
+		"GET /2.0/repositories/example/repo/branch-restrictions/9",
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestResourceBranchRestrictionsCreate_noMatch(t *testing.T) {
+	var posted bool
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/2.0/repositories/example/repo/branch-restrictions":
+			fmt.Fprint(w, `{"values":[
+				{"id":8,"kind":"push","branch_match_kind":"branching_model","branch_type":"production"},
+				{"id":9,"kind":"push","branch_match_kind":"glob","pattern":"main/*"}
+			]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/2.0/repositories/example/repo/branch-restrictions":
+			posted = true
+			fmt.Fprint(w, `{"id":10}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/2.0/repositories/example/repo/branch-restrictions/10":
+			fmt.Fprint(w, `{"id":10,"kind":"push","branch_match_kind":"glob","pattern":"main"}`)
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusTeapot)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceBranchRestriction().Schema, map[string]interface{}{
+		"owner":      "example",
+		"repository": "repo",
+		"kind":       "push",
+		"pattern":    "main",
+	})
+
+	if diags := resourceBranchRestrictionsCreate(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !posted || d.Id() != "10" {
+		t.Errorf("expected a new restriction to be created, got ID %q", d.Id())
+	}
+}
+
+func TestResourceBranchRestrictionsRead_normalized(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":8,"kind":"push","branch_match_kind":"glob","pattern":"release/*",
+			"users":[{"uuid":"{c}","username":"carol"},{"uuid":"{A}"},{"uuid":"{b}"},{"uuid":"{a}"}],
+			"groups":[{"slug":"developers","full_slug":"example:developers"},{"slug":"admins","full_slug":"example:admins"}]}`)
+	})
+
+	raw := map[string]interface{}{
+		"owner":      "example",
+		"repository": "repo",
+		"kind":       "push",
+		"pattern":    " release/* ",
+		"users":      []interface{}{"carol", "{b}", "{a}"},
+		"groups": []interface{}{
+			map[string]interface{}{"owner": "example", "slug": "admins"},
+			map[string]interface{}{"owner": "example", "slug": "Developers"},
+		},
+	}
+
+	resourceSchema := resourceBranchRestriction().Schema
+	d := schema.TestResourceDataRaw(t, resourceSchema, raw)
+	d.SetId("8")
+
+	if diags := resourceBranchRestrictionsRead(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	diff, err := schema.InternalMap(resourceSchema).Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(raw), nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no diff for a reordered restriction, got %#v", diff.Attributes)
+	}
+}
+
+func TestExpandUser(t *testing.T) {
+	cases := map[string]User{
+		"{d2b4a042-7f5e-4b59-9b7b-8a4a3e0b4c11}":      {UUID: "{d2b4a042-7f5e-4b59-9b7b-8a4a3e0b4c11}"},
+		"557058:d2b4a042-7f5e-4b59-9b7b-8a4a3e0b4c11": {AccountID: "557058:d2b4a042-7f5e-4b59-9b7b-8a4a3e0b4c11"},
+		"5b10ac8d82e05b22cc7d4ef5":                    {AccountID: "5b10ac8d82e05b22cc7d4ef5"},
+		"some-user":                                   {Username: "some-user"},
+		"deadbeef":                                    {Username: "deadbeef"},
+	}
+
+	for user, expected := range cases {
+		if got := expandUser(user); got != expected {
+			t.Errorf("expected %q to expand to %#v, got %#v", user, expected, got)
+		}
+	}
+}
+
+func TestCreateBranchRestriction_userKinds(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBranchRestriction().Schema, map[string]interface{}{
+		"owner":      "example",
+		"repository": "repo",
+		"kind":       "push",
+		"pattern":    "main",
+		"users":      []interface{}{"{bot-uuid}", "557058:d2b4a042-7f5e-4b59-9b7b-8a4a3e0b4c11", "legacy-user"},
+	})
+
+	restriction, err := createBranchRestriction(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[User]bool{
+		{UUID: "{bot-uuid}"}: true,
+		{AccountID: "557058:d2b4a042-7f5e-4b59-9b7b-8a4a3e0b4c11"}: true,
+		{Username: "legacy-user"}:                                  true,
+	}
+
+	if len(restriction.Users) != len(expected) {
+		t.Fatalf("expected %d users, got %#v", len(expected), restriction.Users)
+	}
+
+	for _, user := range restriction.Users {
+		if !expected[user] {
+			t.Errorf("unexpected user %#v", user)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBitbucketBranch_basic(t *testing.T) {
+	resourceName := "bitbucket_branch.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	// branches need a commit to point at, so an existing repository is used
+	repo := os.Getenv("BITBUCKET_PIPELINED_REPO")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckPipeSchedule(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketBranchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketBranchConfig(workspace, repo, "release/"+rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketBranchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "workspace", workspace),
+					resource.TestCheckResourceAttr(resourceName, "repository", repo),
+					resource.TestCheckResourceAttr(resourceName, "name", "release/"+rName),
+					resource.TestCheckResourceAttrPair(resourceName, "target", "data.bitbucket_repository.test", "mainbranch"),
+					resource.TestCheckResourceAttrSet(resourceName, "hash"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"target"},
+			},
+		},
+	})
+}
+
+func testAccCheckBitbucketBranchDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(Clients).httpClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bitbucket_branch" {
+			continue
+		}
+
+		_, err := client.Get(fmt.Sprintf("2.0/repositories/%s/%s/refs/branches/%s", rs.Primary.Attributes["workspace"], rs.Primary.Attributes["repository"], rs.Primary.Attributes["name"]))
+
+		if err == nil {
+			return fmt.Errorf("The resource was found should have errored")
+		}
+
+		if !IsNotFound(err) {
+			return fmt.Errorf("Branch still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckBitbucketBranchExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Branch ID is set")
+		}
+		return nil
+	}
+}
+
+func testAccBitbucketBranchConfig(workspace, repo, name string) string {
+	return fmt.Sprintf(`
+data "bitbucket_repository" "test" {
+  workspace = %[1]q
+  slug      = %[2]q
+}
+
+resource "bitbucket_branch" "test" {
+  workspace  = %[1]q
+  repository = %[2]q
+  name       = %[3]q
+  target     = data.bitbucket_repository.test.mainbranch
+}
+`, workspace, repo, name)
+}
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -181,14 +180,18 @@ func resourceBranchingModelsRead(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	branchingModelsReq, _ := client.Get(fmt.Sprintf("2.0/repositories/%s/%s/branching-model", owner, repo))
+	branchingModelsReq, err := client.Get(fmt.Sprintf("2.0/repositories/%s/%s/branching-model", owner, repo))
 
-	if branchingModelsReq.StatusCode == http.StatusNotFound {
+	if IsNotFound(err) {
 		log.Printf("[WARN] Branching Model (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if branchingModelsReq.Body == nil {
 		return diag.Errorf("error getting Branching Model (%s): empty response", d.Id())
 	}
@@ -225,15 +228,47 @@ func resourceBranchingModelsDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	_, err = client.Put(fmt.Sprintf("2.0/repositories/%s/%s/branching-model/settings", owner, repo), nil)
-
+	payload, err := defaultBranchingModelSettings()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	_, err = client.Put(fmt.Sprintf("2.0/repositories/%s/%s/branching-model/settings", owner, repo), payload)
+
 	return diag.FromErr(err)
 }
 
+// defaultBranchingModelSettings is the payload restoring the settings of a new
+// repository: development on the main branch, no production branch and the
+// default prefixes for every branch type.
+func defaultBranchingModelSettings() (*bytes.Buffer, error) {
+	var branchTypes []map[string]interface{}
+	for _, kind := range []string{"feature", "bugfix", "release", "hotfix"} {
+		branchTypes = append(branchTypes, map[string]interface{}{
+			"kind":    kind,
+			"prefix":  kind + "/",
+			"enabled": true,
+		})
+	}
+
+	settings := map[string]interface{}{
+		"development": map[string]interface{}{
+			"use_mainbranch": true,
+		},
+		"production": map[string]interface{}{
+			"enabled": false,
+		},
+		"branch_types": branchTypes,
+	}
+
+	bytedata, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewBuffer(bytedata), nil
+}
+
 func expandBranchingModel(d *schema.ResourceData) *BranchingModel {
 	model := &BranchingModel{}
 
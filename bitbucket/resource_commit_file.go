@@ -0,0 +1,183 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type CommitFileMeta struct {
+	Path   string             `json:"path"`
+	Commit *RepositoryRefHash `json:"commit,omitempty"`
+}
+
+func resourceCommitFile() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCommitFilePut,
+		ReadWithoutTimeout:   resourceCommitFileRead,
+		UpdateWithoutTimeout: resourceCommitFilePut,
+		DeleteWithoutTimeout: resourceCommitFileDelete,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"branch": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"commit_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"author": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"commit_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCommitFilePut(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+	branch := d.Get("branch").(string)
+	path := d.Get("path").(string)
+
+	// Only a change of the content is worth a commit.
+	if d.IsNewResource() || d.HasChange("content") {
+		message := d.Get("commit_message").(string)
+		if message == "" {
+			message = fmt.Sprintf("Update %s", path)
+		}
+
+		fields := map[string]string{
+			path: d.Get("content").(string),
+		}
+
+		if err := commitFiles(m.(Clients).httpClient, d, message, fields); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", workspace, repo, branch, path))
+
+	return resourceCommitFileRead(ctx, d, m)
+}
+
+func resourceCommitFileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	endpoint := fmt.Sprintf("2.0/repositories/%s/%s/src/%s/%s",
+		d.Get("workspace").(string),
+		d.Get("repository").(string),
+		d.Get("branch").(string),
+		d.Get("path").(string),
+	)
+
+	var meta CommitFileMeta
+	err := client.DoAndDecode(http.MethodGet, endpoint+"?format=meta", nil, &meta)
+
+	if IsNotFound(err) {
+		log.Printf("[WARN] Commit File (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	contentRes, err := client.Get(endpoint)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer contentRes.Body.Close()
+
+	content, err := io.ReadAll(contentRes.Body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("content", string(content))
+	if meta.Commit != nil {
+		d.Set("commit_hash", meta.Commit.Hash)
+	}
+
+	return nil
+}
+
+func resourceCommitFileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	path := d.Get("path").(string)
+
+	message := d.Get("commit_message").(string)
+	if message == "" {
+		message = fmt.Sprintf("Delete %s", path)
+	}
+
+	// Paths listed in files without a part of their own are removed.
+	err := commitFiles(m.(Clients).httpClient, d, message, map[string]string{"files": path})
+
+	return diag.FromErr(err)
+}
+
+// commitFiles creates a commit on the configured branch through the src
+// endpoint, which only accepts form payloads.
+func commitFiles(client *Client, d *schema.ResourceData, message string, fields map[string]string) error {
+	var payload bytes.Buffer
+	writer := multipart.NewWriter(&payload)
+
+	fields["message"] = message
+	fields["branch"] = d.Get("branch").(string)
+	if author := d.Get("author").(string); author != "" {
+		fields["author"] = author
+	}
+
+	for name, value := range fields {
+		if err := writer.WriteField(name, value); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	_, err := client.PostMultipart(fmt.Sprintf("2.0/repositories/%s/%s/src",
+		d.Get("workspace").(string),
+		d.Get("repository").(string),
+	), writer.FormDataContentType(), &payload)
+
+	return err
+}
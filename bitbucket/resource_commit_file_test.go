@@ -0,0 +1,94 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBitbucketCommitFile_basic(t *testing.T) {
+	resourceName := "bitbucket_commit_file.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketCommitFileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketCommitFileConfig(workspace, rName, "# test\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketCommitFileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "branch", "main"),
+					resource.TestCheckResourceAttr(resourceName, "path", "README.md"),
+					resource.TestCheckResourceAttr(resourceName, "content", "# test\n"),
+					resource.TestCheckResourceAttrSet(resourceName, "commit_hash"),
+				),
+			},
+			{
+				Config: testAccBitbucketCommitFileConfig(workspace, rName, "# test\n\nupdated\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketCommitFileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content", "# test\n\nupdated\n"),
+					resource.TestCheckResourceAttrSet(resourceName, "commit_hash"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBitbucketCommitFileDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(Clients).httpClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bitbucket_commit_file" {
+			continue
+		}
+
+		_, err := client.Get(fmt.Sprintf("2.0/repositories/%s/%s/src/%s/%s", rs.Primary.Attributes["workspace"], rs.Primary.Attributes["repository"], rs.Primary.Attributes["branch"], rs.Primary.Attributes["path"]))
+
+		if err == nil {
+			return fmt.Errorf("The resource was found should have errored")
+		}
+
+		if !IsNotFound(err) {
+			return fmt.Errorf("Commit File still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckBitbucketCommitFileExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Commit File ID is set")
+		}
+		return nil
+	}
+}
+
+func testAccBitbucketCommitFileConfig(workspace, rName, content string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_commit_file" "test" {
+  workspace      = %[1]q
+  repository     = bitbucket_repository.test.name
+  branch         = "main"
+  path           = "README.md"
+  content        = %[3]q
+  commit_message = "Update README.md"
+}
+`, workspace, rName, content)
+}
@@ -0,0 +1,158 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCommitStatus() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCommitStatusPut,
+		ReadWithoutTimeout:   resourceCommitStatusRead,
+		UpdateWithoutTimeout: resourceCommitStatusPut,
+		DeleteWithoutTimeout: resourceCommitStatusDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"revision": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9a-fA-F]{7,40}$`), "must be a commit hash"),
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SUCCESSFUL", "FAILED", "INPROGRESS", "STOPPED"}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// resourceCommitStatusPut creates or updates the status, Bitbucket replaces
+// the status of a commit with the same key.
+func resourceCommitStatusPut(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+	revision := d.Get("revision").(string)
+
+	status := &CommitStatus{
+		Key:         d.Get("key").(string),
+		State:       d.Get("state").(string),
+		Name:        d.Get("name").(string),
+		URL:         d.Get("url").(string),
+		Description: d.Get("description").(string),
+	}
+
+	bytedata, err := json.Marshal(status)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := client.Post(fmt.Sprintf("2.0/repositories/%s/%s/commit/%s/statuses/build", workspace, repo, revision), bytes.NewBuffer(bytedata))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res.Body.Close()
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", workspace, repo, revision, status.Key))
+
+	return resourceCommitStatusRead(ctx, d, m)
+}
+
+func resourceCommitStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repo, revision, key, err := commitStatusId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var status CommitStatus
+	err = client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/repositories/%s/%s/commit/%s/statuses/build/%s",
+		workspace, repo, revision, url.PathEscape(key)), nil, &status)
+
+	if IsNotFound(err) {
+		log.Printf("[WARN] Commit Status (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("workspace", workspace)
+	d.Set("repository", repo)
+	d.Set("revision", revision)
+	d.Set("key", status.Key)
+	d.Set("state", status.State)
+	d.Set("name", status.Name)
+	d.Set("url", status.URL)
+	d.Set("description", status.Description)
+
+	return nil
+}
+
+func resourceCommitStatusDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The statuses of a commit can't be deleted, they stay with the commit.
+	log.Printf("[DEBUG] Commit Status (%s) can't be deleted, only removing it from state", d.Id())
+
+	return nil
+}
+
+// commitStatusId splits the ID of a commit status, the key comes last as it
+// may contain slashes.
+func commitStatusId(id string) (string, string, string, string, error) {
+	parts := strings.SplitN(id, "/", 4)
+
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", "", fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/REPO-SLUG/COMMIT/KEY", id)
+	}
+
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
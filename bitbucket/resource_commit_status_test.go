@@ -0,0 +1,151 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccBitbucketCommitStatus_basic(t *testing.T) {
+	resourceName := "bitbucket_commit_status.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	// statuses are reported on existing commits, so an existing repository is used
+	repo := os.Getenv("BITBUCKET_PIPELINED_REPO")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckPipeSchedule(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketCommitStatusConfig(workspace, repo, rName, "INPROGRESS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "revision", "data.bitbucket_repository_commit.test", "hash"),
+					resource.TestCheckResourceAttr(resourceName, "key", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", "INPROGRESS"),
+					resource.TestCheckResourceAttr(resourceName, "name", "terraform"),
+					resource.TestCheckResourceAttr(resourceName, "url", "https://example.com/builds/1"),
+				),
+			},
+			{
+				Config: testAccBitbucketCommitStatusConfig(workspace, repo, rName, "SUCCESSFUL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "SUCCESSFUL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceCommitStatusPut(t *testing.T) {
+	var requests []string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.EscapedPath(), body))
+
+		fmt.Fprint(w, `{"key":"ci/build","state":"FAILED","name":"Build","url":"https://ci.example.com/1","description":""}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCommitStatus().Schema, map[string]interface{}{
+		"workspace":  "example",
+		"repository": "repo",
+		"revision":   "abc1234",
+		"key":        "ci/build",
+		"state":      "FAILED",
+		"name":       "Build",
+		"url":        "https://ci.example.com/1",
+	})
+
+	if diags := resourceCommitStatusPut(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []string{
+		`POST /2.0/repositories/example/repo/commit/abc1234/statuses/build {"key":"ci/build","state":"FAILED","name":"Build","url":"https://ci.example.com/1","description":""}`,
+		`GET /2.0/repositories/example/repo/commit/abc1234/statuses/build/ci%2Fbuild `,
+	}
+
+	if len(requests) != len(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Errorf("expected request %q, got %q", expected[i], requests[i])
+		}
+	}
+
+	if d.Id() != "example/repo/abc1234/ci/build" {
+		t.Errorf("unexpected ID %q", d.Id())
+	}
+}
+
+func TestResourceCommitStatusDelete(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCommitStatus().Schema, map[string]interface{}{})
+	d.SetId("example/repo/abc1234/build")
+
+	if diags := resourceCommitStatusDelete(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+}
+
+func TestCommitStatusId(t *testing.T) {
+	workspace, repo, revision, key, err := commitStatusId("example/repo/abc1234/ci/build")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if workspace != "example" || repo != "repo" || revision != "abc1234" || key != "ci/build" {
+		t.Errorf("unexpected ID parts %q %q %q %q", workspace, repo, revision, key)
+	}
+
+	for _, id := range []string{"example/repo/abc1234", "example/repo//build", ""} {
+		if _, _, _, _, err := commitStatusId(id); err == nil {
+			t.Errorf("expected ID %q to be rejected", id)
+		}
+	}
+}
+
+func testAccBitbucketCommitStatusConfig(workspace, repo, rName, state string) string {
+	return fmt.Sprintf(`
+data "bitbucket_repository" "test" {
+  workspace = %[1]q
+  slug      = %[2]q
+}
+
+data "bitbucket_repository_commit" "test" {
+  workspace  = %[1]q
+  repository = %[2]q
+  revision   = data.bitbucket_repository.test.mainbranch
+}
+
+resource "bitbucket_commit_status" "test" {
+  workspace  = %[1]q
+  repository = %[2]q
+  revision   = data.bitbucket_repository_commit.test.hash
+  key        = %[3]q
+  state      = %[4]q
+  name       = "terraform"
+  url        = "https://example.com/builds/1"
+}
+`, workspace, repo, rName, state)
+}
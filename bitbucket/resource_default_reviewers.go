@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -34,8 +35,9 @@ func resourceDefaultReviewers() *schema.Resource {
 		UpdateWithoutTimeout: resourceDefaultReviewersUpdate,
 		DeleteWithoutTimeout: resourceDefaultReviewersDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDefaultReviewersImport,
 		},
+		CustomizeDiff: resourceDefaultReviewersCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"owner": {
@@ -49,25 +51,123 @@ func resourceDefaultReviewers() *schema.Resource {
 				ForceNew: true,
 			},
 			"reviewers": {
+				Type:         schema.TypeSet,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Optional:     true,
+				AtLeastOneOf: []string{"reviewers", "group_slug"},
+			},
+			"group_slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"reviewers", "group_slug"},
+			},
+			"group_reviewers": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
-				Required: true,
+				Computed: true,
+			},
+			"manage_exclusively": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
 }
 
+// resourceDefaultReviewersCustomizeDiff plans the members of group_slug as
+// group_reviewers, so members joining or leaving the group show up as a
+// change on the next plan.
+func resourceDefaultReviewersCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("owner") || !d.NewValueKnown("group_slug") {
+		return d.SetNewComputed("group_reviewers")
+	}
+
+	var members []string
+	if slug := d.Get("group_slug").(string); slug != "" {
+		var err error
+		members, err = groupMemberUUIDs(m.(Clients).httpClient, d.Get("owner").(string), slug)
+		if err != nil {
+			return err
+		}
+	}
+
+	planned := schema.NewSet(schema.HashString, nil)
+	for _, member := range members {
+		planned.Add(member)
+	}
+
+	if !planned.Equal(d.Get("group_reviewers").(*schema.Set)) {
+		return d.SetNew("group_reviewers", members)
+	}
+
+	return nil
+}
+
+// groupMemberUUIDs lists the UUIDs of the members of a group.
+func groupMemberUUIDs(client *Client, workspace, slug string) ([]string, error) {
+	var members []UserGroupMembership
+	err := client.DoAndDecode(http.MethodGet, fmt.Sprintf("1.0/groups/%s/%s/members", workspace, slug), nil, &members)
+	if err != nil {
+		return nil, fmt.Errorf("error reading members of group %s/%s: %w", workspace, slug, err)
+	}
+
+	uuids := make([]string, 0, len(members))
+	for _, member := range members {
+		uuids = append(uuids, member.UUID)
+	}
+
+	return uuids, nil
+}
+
+// refreshGroupReviewers sets group_reviewers to the current members of
+// group_slug, they may have changed since the plan.
+func refreshGroupReviewers(d *schema.ResourceData, client *Client) error {
+	var members []string
+	if slug := d.Get("group_slug").(string); slug != "" {
+		var err error
+		members, err = groupMemberUUIDs(client, d.Get("owner").(string), slug)
+		if err != nil {
+			return err
+		}
+	}
+
+	return d.Set("group_reviewers", members)
+}
+
+// desiredDefaultReviewers are the configured reviewers together with the
+// members of the group.
+func desiredDefaultReviewers(reviewers, groupReviewers interface{}) *schema.Set {
+	return reviewers.(*schema.Set).Union(groupReviewers.(*schema.Set))
+}
+
+func putDefaultReviewer(client *Client, workspace, repo, user string) error {
+	_, err := client.PutOnly(fmt.Sprintf("2.0/repositories/%s/%s/default-reviewers/%s", workspace, repo, url.PathEscape(user)))
+
+	return err
+}
+
+func deleteDefaultReviewer(client *Client, workspace, repo, user string) error {
+	_, err := client.Delete(fmt.Sprintf("2.0/repositories/%s/%s/default-reviewers/%s", workspace, repo, url.PathEscape(user)))
+	if err != nil && !IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
 func resourceDefaultReviewersCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-	prApi := c.ApiClient.PullrequestsApi
+	client := m.(Clients).httpClient
 
 	repo := d.Get("repository").(string)
 	workspace := d.Get("owner").(string)
-	for _, user := range d.Get("reviewers").(*schema.Set).List() {
-		userName := user.(string)
 
-		_, _, err := prApi.RepositoriesWorkspaceRepoSlugDefaultReviewersTargetUsernamePut(c.AuthContext, repo, userName, workspace)
-		if err := handleClientError(err); err != nil {
+	if err := refreshGroupReviewers(d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, user := range desiredDefaultReviewers(d.Get("reviewers"), d.Get("group_reviewers")).List() {
+		if err := putDefaultReviewer(client, workspace, repo, user.(string)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -76,6 +176,26 @@ func resourceDefaultReviewersCreate(ctx context.Context, d *schema.ResourceData,
 	return resourceDefaultReviewersRead(ctx, d, m)
 }
 
+func resourceDefaultReviewersImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	// Accept the plain workspace/repo form next to the ID used in state.
+	id := d.Id()
+	if parts := strings.Split(id, "/"); len(parts) == 2 {
+		id = fmt.Sprintf("%s/reviewers", id)
+	}
+
+	owner, repo, err := defaultReviewersId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+	d.Set("owner", owner)
+	d.Set("repository", repo)
+	d.Set("manage_exclusively", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceDefaultReviewersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(Clients).httpClient
 
@@ -83,79 +203,72 @@ func resourceDefaultReviewersRead(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	resourceURL := fmt.Sprintf("2.0/repositories/%s/%s/default-reviewers", owner, repo)
 
-	res, err := client.Get(resourceURL)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	// Reviewers added outside of Terraform are ignored unless the whole set
+	// is managed. Members of the group are tracked apart from the configured
+	// reviewers, so they don't show up as drift of reviewers.
+	managed := d.Get("reviewers").(*schema.Set)
+	fromGroup := d.Get("group_reviewers").(*schema.Set)
+	exclusive := d.Get("manage_exclusively").(bool)
+
+	var terraformReviewers, groupReviewers []string
+	err = client.GetPaged(fmt.Sprintf("2.0/repositories/%s/%s/default-reviewers", owner, repo), func(raw json.RawMessage) error {
+		var reviewer Reviewer
+		if err := json.Unmarshal(raw, &reviewer); err != nil {
+			return err
+		}
+
+		if fromGroup.Contains(reviewer.UUID) && !managed.Contains(reviewer.UUID) {
+			groupReviewers = append(groupReviewers, reviewer.UUID)
+		} else if exclusive || managed.Contains(reviewer.UUID) {
+			terraformReviewers = append(terraformReviewers, reviewer.UUID)
+		}
 
-	if res.StatusCode == http.StatusNotFound {
+		return nil
+	})
+
+	if IsNotFound(err) {
 		log.Printf("[WARN] Default Reviewers (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	var reviewers PaginatedReviewers
-	var terraformReviewers []string
-
-	for {
-		reviewersResponse, err := client.Get(resourceURL)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		decoder := json.NewDecoder(reviewersResponse.Body)
-		err = decoder.Decode(&reviewers)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		for _, reviewer := range reviewers.Values {
-			terraformReviewers = append(terraformReviewers, reviewer.UUID)
-		}
-
-		if reviewers.Next != "" {
-			nextPage := reviewers.Page + 1
-			resourceURL = fmt.Sprintf("2.0/repositories/%s/%s/default-reviewers?page=%d", owner, repo, nextPage)
-			reviewers = PaginatedReviewers{}
-		} else {
-			break
-		}
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.Set("owner", owner)
 	d.Set("repository", repo)
 	d.Set("reviewers", terraformReviewers)
+	d.Set("group_reviewers", groupReviewers)
 
 	return nil
 }
 
 func resourceDefaultReviewersUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-
-	prApi := c.ApiClient.PullrequestsApi
-	oraw, nraw := d.GetChange("reviewers")
-	o := oraw.(*schema.Set)
-	n := nraw.(*schema.Set)
+	client := m.(Clients).httpClient
 
-	add := n.Difference(o)
-	remove := o.Difference(n)
 	repo := d.Get("repository").(string)
 	workspace := d.Get("owner").(string)
 
-	for _, user := range add.List() {
-		userName := user.(string)
-		_, _, err := prApi.RepositoriesWorkspaceRepoSlugDefaultReviewersTargetUsernamePut(c.AuthContext, repo, userName, workspace)
-		if err := handleClientError(err); err != nil {
+	oldReviewers, _ := d.GetChange("reviewers")
+	oldGroupReviewers, _ := d.GetChange("group_reviewers")
+	o := desiredDefaultReviewers(oldReviewers, oldGroupReviewers)
+
+	if err := refreshGroupReviewers(d, client); err != nil {
+		return diag.FromErr(err)
+	}
+	n := desiredDefaultReviewers(d.Get("reviewers"), d.Get("group_reviewers"))
+
+	for _, user := range n.Difference(o).List() {
+		if err := putDefaultReviewer(client, workspace, repo, user.(string)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	for _, user := range remove.List() {
-		userName := user.(string)
-		_, err := prApi.RepositoriesWorkspaceRepoSlugDefaultReviewersTargetUsernameDelete(c.AuthContext, repo, userName, workspace)
-		if err := handleClientError(err); err != nil {
+	// Members that left the group are removed as well.
+	for _, user := range o.Difference(n).List() {
+		if err := deleteDefaultReviewer(client, workspace, repo, user.(string)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -164,15 +277,12 @@ func resourceDefaultReviewersUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceDefaultReviewersDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-	prApi := c.ApiClient.PullrequestsApi
+	client := m.(Clients).httpClient
 
 	repo := d.Get("repository").(string)
 	workspace := d.Get("owner").(string)
-	for _, user := range d.Get("reviewers").(*schema.Set).List() {
-		userName := user.(string)
-		_, err := prApi.RepositoriesWorkspaceRepoSlugDefaultReviewersTargetUsernameDelete(c.AuthContext, repo, userName, workspace)
-		if err := handleClientError(err); err != nil {
+	for _, user := range desiredDefaultReviewers(d.Get("reviewers"), d.Get("group_reviewers")).List() {
+		if err := deleteDefaultReviewer(client, workspace, repo, user.(string)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -1,13 +1,17 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -35,10 +39,193 @@ func TestAccBitbucketDefaultReviewers_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccBitbucketDefaultReviewersImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func testAccBitbucketDefaultReviewersImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["owner"], rs.Primary.Attributes["repository"]), nil
+	}
+}
+
+func TestResourceDefaultReviewersRead_manageExclusively(t *testing.T) {
+	for exclusive, expected := range map[bool][]string{
+		true:  {"{managed}", "{manual}"},
+		false: {"{managed}"},
+	} {
+		client := testClient(t, testPagedHandler(t,
+			`[{"uuid":"{manual}"}]`,
+			`[{"uuid":"{managed}"}]`,
+		))
+
+		d := schema.TestResourceDataRaw(t, resourceDefaultReviewers().Schema, map[string]interface{}{
+			"owner":              "example",
+			"repository":         "repo",
+			"reviewers":          []interface{}{"{managed}"},
+			"manage_exclusively": exclusive,
+		})
+		d.SetId("example/repo/reviewers")
+
+		if diags := resourceDefaultReviewersRead(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		reviewers := d.Get("reviewers").(*schema.Set)
+		if reviewers.Len() != len(expected) {
+			t.Errorf("expected reviewers %v with manage_exclusively = %t, got %v", expected, exclusive, reviewers.List())
+		}
+
+		for _, reviewer := range expected {
+			if !reviewers.Contains(reviewer) {
+				t.Errorf("expected reviewer %s with manage_exclusively = %t", reviewer, exclusive)
+			}
+		}
+	}
+}
+
+// testDefaultReviewersServer serves the members of the devs group and records
+// the changes made to the default reviewers.
+func testDefaultReviewersServer(t *testing.T, members string, requests *[]string) *Client {
+	t.Helper()
+
+	reviewers := map[string]bool{}
+	return testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/1.0/groups/example/devs/members":
+			fmt.Fprint(w, members)
+		case r.Method == http.MethodGet && r.URL.Path == "/2.0/repositories/example/repo/default-reviewers":
+			var values []string
+			for uuid := range reviewers {
+				values = append(values, fmt.Sprintf(`{"uuid":%q}`, uuid))
+			}
+			fmt.Fprintf(w, `{"values":[%s]}`, strings.Join(values, ","))
+		case strings.HasPrefix(r.URL.Path, "/2.0/repositories/example/repo/default-reviewers/"):
+			user := strings.TrimPrefix(r.URL.Path, "/2.0/repositories/example/repo/default-reviewers/")
+			*requests = append(*requests, r.Method+" "+user)
+			if r.Method == http.MethodDelete {
+				delete(reviewers, user)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			reviewers[user] = true
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusTeapot)
+		}
+	})
+}
+
+func TestResourceDefaultReviewersCreate_group(t *testing.T) {
+	var requests []string
+	client := testDefaultReviewersServer(t, `[{"uuid":"{alice}"},{"uuid":"{bob}"},{"uuid":"{carol}"}]`, &requests)
+
+	d := schema.TestResourceDataRaw(t, resourceDefaultReviewers().Schema, map[string]interface{}{
+		"owner":      "example",
+		"repository": "repo",
+		"reviewers":  []interface{}{"{lead}"},
+		"group_slug": "devs",
+	})
+
+	if diags := resourceDefaultReviewersCreate(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	sort.Strings(requests)
+	expected := []string{"PUT {alice}", "PUT {bob}", "PUT {carol}", "PUT {lead}"}
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+
+	if reviewers := d.Get("reviewers").(*schema.Set); reviewers.Len() != 1 || !reviewers.Contains("{lead}") {
+		t.Errorf("expected the group members to be kept out of reviewers, got %v", reviewers.List())
+	}
+
+	if groupReviewers := d.Get("group_reviewers").(*schema.Set); groupReviewers.Len() != 3 {
+		t.Errorf("expected the 3 group members as group reviewers, got %v", groupReviewers.List())
+	}
+}
+
+func TestResourceDefaultReviewersUpdate_memberLeftGroup(t *testing.T) {
+	var requests []string
+	client := testDefaultReviewersServer(t, `[{"uuid":"{alice}"},{"uuid":"{bob}"}]`, &requests)
+
+	resourceSchema := resourceDefaultReviewers().Schema
+	raw := map[string]interface{}{
+		"owner":      "example",
+		"repository": "repo",
+		"group_slug": "devs",
+	}
+	current := schema.TestResourceDataRaw(t, resourceSchema, raw)
+	current.SetId("example/repo/reviewers")
+	current.Set("group_reviewers", []interface{}{"{alice}", "{bob}", "{carol}"})
+	state := current.State()
+
+	diff, err := schema.InternalMap(resourceSchema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := schema.InternalMap(resourceSchema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := resourceDefaultReviewersUpdate(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(requests) != 1 || requests[0] != "DELETE {carol}" {
+		t.Errorf("expected only the member that left to be removed, got %v", requests)
+	}
+}
+
+func TestResourceDefaultReviewersDiff_groupMembershipChanged(t *testing.T) {
+	var requests []string
+	client := testDefaultReviewersServer(t, `[{"uuid":"{alice}"},{"uuid":"{bob}"},{"uuid":"{dave}"}]`, &requests)
+
+	raw := map[string]interface{}{
+		"owner":      "example",
+		"repository": "repo",
+		"group_slug": "devs",
+	}
+	current := schema.TestResourceDataRaw(t, resourceDefaultReviewers().Schema, raw)
+	current.SetId("example/repo/reviewers")
+	current.Set("group_reviewers", []interface{}{"{alice}", "{bob}", "{carol}"})
+
+	diff, err := resourceDefaultReviewers().Diff(context.Background(), current.State(), terraform.NewResourceConfigRaw(raw), Clients{httpClient: client})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff == nil {
+		t.Fatal("expected a change of the group reviewers")
+	}
+
+	var added, removed bool
+	for k, attr := range diff.Attributes {
+		if !strings.HasPrefix(k, "group_reviewers.") {
+			continue
+		}
+		added = added || attr.New == "{dave}"
+		removed = removed || (attr.Old == "{carol}" && attr.NewRemoved)
+	}
+
+	if !added || !removed {
+		t.Errorf("expected dave to be planned and carol to be removed, got %#v", diff.Attributes)
+	}
+}
+
 func testAccBitbucketDefaultReviewersConfig(owner, rName string) string {
 	return fmt.Sprintf(`
 data "bitbucket_current_user" "test" {}
@@ -36,9 +36,10 @@ func resourceDeployKey() *schema.Resource {
 				ForceNew: true,
 			},
 			"key": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentSSHKeys,
 			},
 			"label": {
 				Type:     schema.TypeString,
@@ -107,7 +108,7 @@ func resourceDeployKeysRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	deployKey, deployKeyRes, err := deployApi.RepositoriesWorkspaceRepoSlugDeployKeysKeyIdGet(c.AuthContext, keyId, repo, workspace)
 
-	if deployKeyRes.StatusCode == http.StatusNotFound {
+	if deployKeyRes != nil && deployKeyRes.StatusCode == http.StatusNotFound {
 		log.Printf("[WARN] Deploy Key (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -121,7 +122,7 @@ func resourceDeployKeysRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	d.Set("repository", repo)
 	d.Set("workspace", workspace)
-	d.Set("key", d.Get("key").(string))
+	setSSHKey(d, deployKey.Key)
 	d.Set("label", deployKey.Label)
 	d.Set("comment", deployKey.Comment)
 	d.Set("key_id", keyId)
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -20,6 +19,7 @@ type Deployment struct {
 	Name         string        `json:"name"`
 	Stage        *Stage        `json:"environment_type"`
 	UUID         string        `json:"uuid,omitempty"`
+	Rank         int           `json:"rank,omitempty"`
 	Restrictions *Restrictions `json:"restrictions,omitempty"`
 }
 
@@ -36,8 +36,9 @@ type Changes struct {
 }
 
 type Change struct {
-	Name         string       `json:"name,omitempty"`
-	Restrictions Restrictions `json:"restrictions,omitempty"`
+	Name         string        `json:"name,omitempty"`
+	Rank         *int          `json:"rank,omitempty"`
+	Restrictions *Restrictions `json:"restrictions,omitempty"`
 }
 
 func resourceDeployment() *schema.Resource {
@@ -47,7 +48,7 @@ func resourceDeployment() *schema.Resource {
 		ReadWithoutTimeout:   resourceDeploymentRead,
 		DeleteWithoutTimeout: resourceDeploymentDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDeploymentImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -55,6 +56,12 @@ func resourceDeployment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"rank": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -146,6 +153,26 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, m int
 	d.Set("uuid", deployment.UUID)
 	d.SetId(fmt.Sprintf("%s:%s", d.Get("repository"), deployment.UUID))
 
+	// New environments are ranked last in their stage, moving them is a
+	// change of its own.
+	// nolint:staticcheck
+	if v, ok := d.GetOkExists("rank"); ok && v.(int) != deployment.Rank {
+		rank := v.(int)
+		bytedata, err := json.Marshal(&Changes{Change: &Change{Rank: &rank}})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		res, err := client.Post(fmt.Sprintf("2.0/repositories/%s/environments/%s/changes/",
+			d.Get("repository").(string),
+			deployment.UUID,
+		), bytes.NewBuffer(bytedata))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		res.Body.Close()
+	}
+
 	return resourceDeploymentRead(ctx, d, m)
 }
 
@@ -162,7 +189,7 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, m inter
 		deployId,
 	))
 
-	if res != nil && res.StatusCode == http.StatusNotFound {
+	if IsNotFound(err) {
 		log.Printf("[WARN] Deployment (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -189,7 +216,10 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	d.Set("uuid", deploy.UUID)
 	d.Set("name", deploy.Name)
-	d.Set("stage", deploy.Stage.Name)
+	if deploy.Stage != nil {
+		d.Set("stage", deploy.Stage.Name)
+	}
+	d.Set("rank", deploy.Rank)
 	d.Set("repository", repoId)
 	d.Set("restrictions", flattenRestrictions(deploy.Restrictions))
 
@@ -207,8 +237,14 @@ func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m int
 		rvcr.Change.Name = d.Get("name").(string)
 	}
 
+	if d.HasChange("rank") {
+		rank := d.Get("rank").(int)
+		rvcr.Change.Rank = &rank
+	}
+
 	if d.HasChange("restrictions") {
-		rvcr.Change.Restrictions = expandRestrictions(d.Get("restrictions").([]interface{}))
+		restrictions := expandRestrictions(d.Get("restrictions").([]interface{}))
+		rvcr.Change.Restrictions = &restrictions
 	}
 
 	log.Printf("[DEBUG] deployment update req: %#v", rvcr)
@@ -270,12 +306,28 @@ func flattenRestrictions(rp *Restrictions) []interface{} {
 	return []interface{}{m}
 }
 
+func resourceDeploymentImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	repoId, deployId, err := deploymentId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoId, deployId))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// deploymentId accepts both the REPO-ID:DEPLOYMENT-UUID format used in state
+// and WORKSPACE/REPO-SLUG/DEPLOYMENT-UUID, which is easier to type on import.
 func deploymentId(id string) (string, string, error) {
-	parts := strings.Split(id, ":")
+	if parts := strings.Split(id, ":"); len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
 
-	if len(parts) != 2 {
-		return "", "", fmt.Errorf("unexpected format of ID (%q), expected REPO-ID:DEPLOYMENT-UUID", id)
+	parts := strings.Split(id, "/")
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return strings.Join(parts[:2], "/"), parts[2], nil
 	}
 
-	return parts[0], parts[1], nil
+	return "", "", fmt.Errorf("unexpected format of ID (%q), expected REPO-ID:DEPLOYMENT-UUID or WORKSPACE/REPO-SLUG/DEPLOYMENT-UUID", id)
 }
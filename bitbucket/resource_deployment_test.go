@@ -1,13 +1,16 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -41,6 +44,12 @@ func TestAccBitbucketDeployment_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccBitbucketDeploymentImportStateIdFunc(resourceName),
+			},
 			{
 				Config: testAccBitbucketDeployment(owner, rName, rNameUpdated),
 				Check: resource.ComposeTestCheckFunc(
@@ -111,6 +120,105 @@ func TestAccBitbucketDeployment_admin(t *testing.T) {
 	})
 }
 
+func TestAccBitbucketDeployment_rank(t *testing.T) {
+	var deploy Deployment
+
+	resourceName := "bitbucket_deployment.test"
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	owner := os.Getenv("BITBUCKET_TEAM")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDeploymentRank(owner, rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketDeploymentExists(resourceName, &deploy),
+					resource.TestCheckResourceAttr(resourceName, "rank", "0"),
+					resource.TestCheckResourceAttr("bitbucket_deployment.other", "rank", "1"),
+				),
+			},
+			{
+				Config: testAccBitbucketDeploymentRank(owner, rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketDeploymentExists(resourceName, &deploy),
+					resource.TestCheckResourceAttr(resourceName, "rank", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceDeploymentUpdate_rank(t *testing.T) {
+	var payload string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.0/repositories/example/repo/environments/{env}/changes/", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		payload = string(body)
+	})
+	mux.HandleFunc("/2.0/repositories/example/repo/environments/{env}", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"uuid":"{env}","name":"Production","environment_type":{"name":"Production"},"rank":2,"restrictions":{"admin_only":true}}`))
+	})
+	client := testClient(t, mux.ServeHTTP)
+
+	resourceSchema := resourceDeployment().Schema
+	raw := map[string]interface{}{
+		"repository": "example/repo",
+		"name":       "Production",
+		"stage":      "Production",
+		"rank":       0,
+		"restrictions": []interface{}{
+			map[string]interface{}{"admin_only": true},
+		},
+	}
+
+	current := schema.TestResourceDataRaw(t, resourceSchema, raw)
+	current.SetId("example/repo:{env}")
+	current.Set("uuid", "{env}")
+	state := current.State()
+
+	raw["rank"] = 2
+	diff, err := schema.InternalMap(resourceSchema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := schema.InternalMap(resourceSchema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := resourceDeploymentUpdate(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if expected := `{"change":{"rank":2}}`; payload != expected {
+		t.Errorf("expected payload %s, got %s", expected, payload)
+	}
+
+	if rank := d.Get("rank").(int); rank != 2 {
+		t.Errorf("expected rank 2 after the update, got %d", rank)
+	}
+
+	if adminOnly := d.Get("restrictions.0.admin_only").(bool); !adminOnly {
+		t.Error("expected the restrictions to be left alone")
+	}
+}
+
+func testAccBitbucketDeploymentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["repository"], rs.Primary.Attributes["uuid"]), nil
+	}
+}
+
 func testAccCheckBitbucketDeploymentDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(Clients).httpClient
 	rs, ok := s.RootModule().Resources["bitbucket_deployment.test"]
@@ -173,3 +281,27 @@ resource "bitbucket_deployment" "test" {
 }
 `, workspace, repoName, deployName, admin)
 }
+
+func testAccBitbucketDeploymentRank(workspace, repoName string, rank int) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_deployment" "test" {
+  name       = "first"
+  stage      = "Staging"
+  repository = bitbucket_repository.test.id
+  rank       = %[3]d
+}
+
+resource "bitbucket_deployment" "other" {
+  name       = "second"
+  stage      = "Staging"
+  repository = bitbucket_repository.test.id
+
+  depends_on = [bitbucket_deployment.test]
+}
+`, workspace, repoName, rank)
+}
@@ -22,11 +22,20 @@ func resourceDeploymentVariable() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-					return nil, fmt.Errorf("unexpected format of ID (%q), expected DEPLOYMENT-ID/DEPLOYMENT-VARIABLE-ID", d.Id())
+				for _, part := range idParts {
+					if part == "" {
+						return nil, fmt.Errorf("unexpected format of ID (%q), expected DEPLOYMENT-ID/DEPLOYMENT-VARIABLE-ID or WORKSPACE/REPO-SLUG/DEPLOYMENT-UUID/DEPLOYMENT-VARIABLE-ID", d.Id())
+					}
 				}
-				d.SetId(idParts[2])
-				d.Set("deployment", strings.Join([]string{idParts[0], idParts[1]}, "/"))
+				switch len(idParts) {
+				case 3:
+					d.Set("deployment", strings.Join([]string{idParts[0], idParts[1]}, "/"))
+				case 4:
+					d.Set("deployment", fmt.Sprintf("%s/%s:%s", idParts[0], idParts[1], idParts[2]))
+				default:
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected DEPLOYMENT-ID/DEPLOYMENT-VARIABLE-ID or WORKSPACE/REPO-SLUG/DEPLOYMENT-UUID/DEPLOYMENT-VARIABLE-ID", d.Id())
+				}
+				d.SetId(idParts[len(idParts)-1])
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -53,6 +62,7 @@ func resourceDeploymentVariable() *schema.Resource {
 			"deployment": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 		},
 	}
@@ -107,7 +117,7 @@ func resourceDeploymentVariableRead(ctx context.Context, d *schema.ResourceData,
 
 	rvRes, res, err := pipeApi.GetDeploymentVariables(c.AuthContext, workspace, repoSlug, deployment)
 
-	if res.StatusCode == http.StatusNotFound {
+	if res != nil && res.StatusCode == http.StatusNotFound {
 		log.Printf("[WARN] Deployment Variable (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
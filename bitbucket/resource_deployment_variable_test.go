@@ -37,6 +37,12 @@ func TestAccBitbucketDeploymentVariable_basic(t *testing.T) {
 				ImportStateIdFunc: testAccBitbucketDeploymentVariableImportStateIdFunc(resourceName),
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccBitbucketDeploymentVariableWorkspaceImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccBitbucketDeploymentVariableConfig(owner, rName, "test-2", false),
 				Check: resource.ComposeTestCheckFunc(
@@ -213,3 +219,14 @@ func testAccBitbucketDeploymentVariableImportStateIdFunc(resourceName string) re
 		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["deployment"], rs.Primary.ID), nil
 	}
 }
+
+func testAccBitbucketDeploymentVariableWorkspaceImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+		repository, deployment := parseDeploymentId(rs.Primary.Attributes["deployment"])
+		return fmt.Sprintf("%s/%s/%s", repository, deployment, rs.Primary.ID), nil
+	}
+}
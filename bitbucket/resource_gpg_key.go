@@ -0,0 +1,143 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// GpgKey is a public GPG key used to verify commits signed by a user
+type GpgKey struct {
+	Key         string `json:"key,omitempty"`
+	Name        string `json:"name,omitempty"`
+	KeyID       string `json:"key_id,omitempty"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+func resourceGpgKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGpgKeysCreate,
+		ReadWithoutTimeout:   resourceGpgKeysRead,
+		DeleteWithoutTimeout: resourceGpgKeysDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppressEquivalentGPGKeys,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGpgKeysCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	user := d.Get("user").(string)
+	gpgKey := &GpgKey{
+		Key:  normalizeGPGKey(d.Get("key").(string)),
+		Name: d.Get("name").(string),
+	}
+
+	bytedata, err := json.Marshal(gpgKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var created GpgKey
+	err = client.DoAndDecode(http.MethodPost, fmt.Sprintf("2.0/users/%s/gpg-keys", user), bytes.NewBuffer(bytedata), &created)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", user, created.Fingerprint))
+
+	return resourceGpgKeysRead(ctx, d, m)
+}
+
+func resourceGpgKeysRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	user, fingerprint, err := gpgKeyId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var gpgKey GpgKey
+	err = client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/users/%s/gpg-keys/%s", user, fingerprint), nil, &gpgKey)
+
+	if IsNotFound(err) {
+		log.Printf("[WARN] GPG Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("user", user)
+	if normalizeGPGKey(gpgKey.Key) != normalizeGPGKey(d.Get("key").(string)) {
+		d.Set("key", gpgKey.Key)
+	}
+	d.Set("name", gpgKey.Name)
+	d.Set("key_id", gpgKey.KeyID)
+	d.Set("fingerprint", gpgKey.Fingerprint)
+
+	return nil
+}
+
+func resourceGpgKeysDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	user, fingerprint, err := gpgKeyId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Delete(fmt.Sprintf("2.0/users/%s/gpg-keys/%s", user, fingerprint))
+
+	return diag.FromErr(err)
+}
+
+func gpgKeyId(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected USER-ID/FINGERPRINT", id)
+	}
+
+	return parts[0], parts[1], nil
+}
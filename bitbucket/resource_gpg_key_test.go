@@ -0,0 +1,132 @@
+package bitbucket
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+func TestAccBitbucketGpgKey_basic(t *testing.T) {
+	resourceName := "bitbucket_gpg_key.test"
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	publicKey, err := randGPGPublicKey(rName, os.Getenv("BITBUCKET_USERNAME"))
+	if err != nil {
+		t.Fatalf("error generating random GPG key: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketGpgKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the trailing newline of the armored key must not cause a diff
+				Config: testAccBitbucketGpgKeyConfig(publicKey+"\n", rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketGpgKeyExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "user", "data.bitbucket_current_user.test", "uuid"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "fingerprint"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key"},
+			},
+		},
+	})
+}
+
+func testAccCheckBitbucketGpgKeyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(Clients).httpClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bitbucket_gpg_key" {
+			continue
+		}
+
+		_, err := client.Get(fmt.Sprintf("2.0/users/%s/gpg-keys/%s", rs.Primary.Attributes["user"], rs.Primary.Attributes["fingerprint"]))
+
+		if err == nil {
+			return fmt.Errorf("The resource was found should have errored")
+		}
+
+		if !IsNotFound(err) {
+			return fmt.Errorf("GPG Key still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckBitbucketGpgKeyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GPG Key ID is set")
+		}
+		return nil
+	}
+}
+
+func testAccBitbucketGpgKeyConfig(key, name string) string {
+	return fmt.Sprintf(`
+data "bitbucket_current_user" "test" {}
+
+resource "bitbucket_gpg_key" "test" {
+  user = data.bitbucket_current_user.test.uuid
+  key  = %[1]q
+  name = %[2]q
+}
+`, key, name)
+}
+
+func randGPGPublicKey(name, email string) (string, error) {
+	entity, err := openpgp.NewEntity(name, "", email, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if err := entity.Serialize(w); err != nil {
+		return "", err
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+func TestNormalizeGPGKey(t *testing.T) {
+	const key = "-----BEGIN PGP PUBLIC KEY BLOCK-----\n\nmQENBF\n-----END PGP PUBLIC KEY BLOCK-----"
+
+	for _, input := range []string{
+		key,
+		key + "\n",
+		"\n" + key + "\n\n",
+		"-----BEGIN PGP PUBLIC KEY BLOCK-----\r\n\r\nmQENBF\r\n-----END PGP PUBLIC KEY BLOCK-----\r\n",
+	} {
+		if got := normalizeGPGKey(input); got != key {
+			t.Errorf("normalizeGPGKey(%q) = %q, want %q", input, got, key)
+		}
+	}
+}
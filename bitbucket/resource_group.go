@@ -7,7 +7,7 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,9 +18,9 @@ import (
 type UserGroup struct {
 	Name                    string `json:"name,omitempty"`
 	Slug                    string `json:"slug,omitempty"`
-	AutoAdd                 bool   `json:"auto_add,omitempty"`
+	AutoAdd                 bool   `json:"auto_add"`
 	Permission              string `json:"permission,omitempty"`
-	EmailForwardingDisabled bool   `json:"email_forwarding_disabled,omitempty"`
+	EmailForwardingDisabled bool   `json:"email_forwarding_disabled"`
 }
 
 func resourceGroup() *schema.Resource {
@@ -42,6 +42,9 @@ func resourceGroup() *schema.Resource {
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
+				// The slug is derived from the name server-side and is part of
+				// the ID, so a rename has to recreate the group.
+				ForceNew: true,
 			},
 			"slug": {
 				Type:     schema.TypeString,
@@ -71,7 +74,7 @@ func resourceGroupsCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	log.Printf("[DEBUG] Group Request: %#v", group)
 
 	workspace := d.Get("workspace").(string)
-	body := []byte(fmt.Sprintf("name=%s", group.Name))
+	body := []byte(url.Values{"name": {group.Name}}.Encode())
 	groupReq, err := client.PostNonJson(fmt.Sprintf("1.0/groups/%s", workspace), bytes.NewBuffer(body))
 	if err != nil {
 		return diag.FromErr(err)
@@ -104,14 +107,18 @@ func resourceGroupsRead(ctx context.Context, d *schema.ResourceData, m interface
 		return diag.FromErr(err)
 	}
 
-	groupsReq, _ := client.Get(fmt.Sprintf("1.0/groups/%s/%s", workspace, slug))
+	groupsReq, err := client.Get(fmt.Sprintf("1.0/groups/%s/%s", workspace, slug))
 
-	if groupsReq.StatusCode == http.StatusNotFound {
+	if IsNotFound(err) {
 		log.Printf("[WARN] Group (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if groupsReq.Body == nil {
 		return diag.Errorf("error reading Group (%s): empty response", d.Id())
 	}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,7 +13,18 @@ import (
 )
 
 type UserGroupMembership struct {
-	UUID string `json:"uuid,omitempty"`
+	UUID      string `json:"uuid,omitempty"`
+	AccountID string `json:"account_id,omitempty"`
+}
+
+// matches reports whether the member is identified by user, which may be a
+// UUID with or without braces or an account ID.
+func (m *UserGroupMembership) matches(user string) bool {
+	if m.AccountID != "" && m.AccountID == user {
+		return true
+	}
+
+	return m.UUID != "" && strings.Trim(m.UUID, "{}") == strings.Trim(user, "{}")
 }
 
 func resourceGroupMembership() *schema.Resource {
@@ -76,14 +86,18 @@ func resourceGroupMembershipsRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	groupsReq, _ := client.Get(fmt.Sprintf("1.0/groups/%s/%s/members", workspace, slug))
+	groupsReq, err := client.Get(fmt.Sprintf("1.0/groups/%s/%s/members", workspace, slug))
 
-	if groupsReq.StatusCode == http.StatusNotFound {
+	if IsNotFound(err) {
 		log.Printf("[WARN] Group Membership (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if groupsReq.Body == nil {
 		return diag.Errorf("error reading Group Membership (%s): empty response", d.Id())
 	}
@@ -104,27 +118,25 @@ func resourceGroupMembershipsRead(ctx context.Context, d *schema.ResourceData, m
 
 	log.Printf("[DEBUG] Group Membership Response Decoded: %#v", members)
 
-	if len(members) == 0 {
-		return diag.Errorf("error getting Group Members (%s): empty response", d.Id())
-	}
-
 	var member *UserGroupMembership
 	for _, mbr := range members {
-		if mbr.UUID == uuid {
+		if mbr.matches(uuid) {
 			member = mbr
 			break
 		}
 	}
 
 	if member == nil {
-		return diag.Errorf("error getting Group Member (%s): not found", d.Id())
+		log.Printf("[WARN] Group Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
 
 	log.Printf("[DEBUG] Group Member Response Decoded: %#v", member)
 
 	d.Set("workspace", workspace)
 	d.Set("group_slug", slug)
-	d.Set("uuid", member.UUID)
+	d.Set("uuid", uuid)
 
 	return nil
 }
@@ -131,3 +131,24 @@ resource "bitbucket_group_membership" "test" {
 }
 `, workspace, rName)
 }
+
+func TestUserGroupMembershipMatches(t *testing.T) {
+	member := &UserGroupMembership{
+		UUID:      "{d7dd0e2d-3994-4a50-a9ee-d260b6cefdab}",
+		AccountID: "557058:c0b72ad0-1cb5-4018-9cdc-0cde8492c443",
+	}
+
+	for _, user := range []string{
+		"{d7dd0e2d-3994-4a50-a9ee-d260b6cefdab}",
+		"d7dd0e2d-3994-4a50-a9ee-d260b6cefdab",
+		"557058:c0b72ad0-1cb5-4018-9cdc-0cde8492c443",
+	} {
+		if !member.matches(user) {
+			t.Errorf("expected member to match %q", user)
+		}
+	}
+
+	if member.matches("{00000000-0000-0000-0000-000000000000}") {
+		t.Error("expected member not to match a different UUID")
+	}
+}
@@ -53,6 +53,16 @@ func TestAccBitbucketGroup_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "email_forwarding_disabled", "true"),
 				),
 			},
+			{
+				Config: testAccBitbucketGroupPermissionConfig(workspace, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "auto_add", "false"),
+					resource.TestCheckResourceAttr(resourceName, "permission", "write"),
+					resource.TestCheckResourceAttr(resourceName, "email_forwarding_disabled", "false"),
+				),
+			},
 		},
 	})
 }
@@ -139,3 +149,19 @@ resource "bitbucket_group" "test" {
 }
 `, workspace, rName)
 }
+
+func testAccBitbucketGroupPermissionConfig(workspace, rName string) string {
+	return fmt.Sprintf(`
+data "bitbucket_workspace" "test" {
+  workspace = %[1]q
+}
+
+resource "bitbucket_group" "test" {
+  workspace                 = data.bitbucket_workspace.test.id
+  name                      = %[2]q
+  auto_add                  = false
+  permission                = "write"
+  email_forwarding_disabled = false
+}
+`, workspace, rName)
+}
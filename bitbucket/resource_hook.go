@@ -23,7 +23,11 @@ type Hook struct {
 	Description          string   `json:"description,omitempty"`
 	Active               bool     `json:"active"`
 	SkipCertVerification bool     `json:"skip_cert_verification"`
+	HistoryEnabled       bool     `json:"history_enabled"`
 	Events               []string `json:"events,omitempty"`
+	// Secret is write-only, a null value removes it from the hook
+	Secret    *string `json:"secret"`
+	SecretSet bool    `json:"secret_set,omitempty"`
 }
 
 func resourceHook() *schema.Resource {
@@ -112,6 +116,16 @@ func resourceHook() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"history_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"secret": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
 		},
 	}
 }
@@ -129,9 +143,14 @@ func createHook(d *schema.ResourceData) *Hook {
 		Description:          d.Get("description").(string),
 		Active:               d.Get("active").(bool),
 		SkipCertVerification: d.Get("skip_cert_verification").(bool),
+		HistoryEnabled:       d.Get("history_enabled").(bool),
 		Events:               events,
 	}
 
+	if secret := d.Get("secret").(string); secret != "" {
+		hook.Secret = &secret
+	}
+
 	return hook
 }
 
@@ -144,25 +163,15 @@ func resourceHookCreate(ctx context.Context, d *schema.ResourceData, m interface
 		return diag.FromErr(err)
 	}
 
-	hookReq, err := client.Post(fmt.Sprintf("2.0/repositories/%s/%s/hooks",
+	err = client.DoAndDecode(http.MethodPost, fmt.Sprintf("2.0/repositories/%s/%s/hooks",
 		d.Get("owner").(string),
 		d.Get("repository").(string),
-	), bytes.NewBuffer(payload))
+	), bytes.NewBuffer(payload), &hook)
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	body, readerr := io.ReadAll(hookReq.Body)
-	if readerr != nil {
-		return diag.FromErr(readerr)
-	}
-
-	decodeerr := json.Unmarshal(body, &hook)
-	if decodeerr != nil {
-		return diag.FromErr(decodeerr)
-	}
-
 	d.SetId(hook.UUID)
 
 	return resourceHookRead(ctx, d, m)
@@ -176,7 +185,7 @@ func resourceHookRead(ctx context.Context, d *schema.ResourceData, m interface{}
 		url.PathEscape(d.Id()),
 	))
 
-	if hookReq.StatusCode == http.StatusNotFound {
+	if IsNotFound(err) {
 		log.Printf("[WARN] Repository Hook (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -206,7 +215,13 @@ func resourceHookRead(ctx context.Context, d *schema.ResourceData, m interface{}
 		d.Set("active", hook.Active)
 		d.Set("url", hook.URL)
 		d.Set("skip_cert_verification", hook.SkipCertVerification)
+		d.Set("history_enabled", hook.HistoryEnabled)
 		d.Set("events", hook.Events)
+
+		// The secret is never returned, only whether one is configured.
+		if !hook.SecretSet {
+			d.Set("secret", "")
+		}
 	}
 
 	return nil
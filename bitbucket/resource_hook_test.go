@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	uuid "github.com/satori/go.uuid"
 )
@@ -53,6 +54,7 @@ func TestAccBitbucketHook_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "url", "https://httpbin.org"),
 					resource.TestCheckResourceAttr(resourceName, "skip_cert_verification", "true"),
 					resource.TestCheckResourceAttr(resourceName, "skip_cert_verification", "true"),
+					resource.TestCheckResourceAttr(resourceName, "history_enabled", "true"),
 					resource.TestCheckResourceAttr(resourceName, "events.#", "2"),
 				),
 			},
@@ -72,6 +74,69 @@ func TestAccBitbucketHook_basic(t *testing.T) {
 	})
 }
 
+func TestAccBitbucketHook_secret(t *testing.T) {
+	var hook Hook
+	resourceName := "bitbucket_hook.test"
+	testUser := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketHookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketHookSecretConfig(testUser, rName, false, "first-secret"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketHookExists(resourceName, &hook),
+					resource.TestCheckResourceAttr(resourceName, "skip_cert_verification", "false"),
+					resource.TestCheckResourceAttr(resourceName, "secret", "first-secret"),
+				),
+			},
+			{
+				Config: testAccBitbucketHookSecretConfig(testUser, rName, true, "second-secret"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketHookExists(resourceName, &hook),
+					resource.TestCheckResourceAttr(resourceName, "skip_cert_verification", "true"),
+					resource.TestCheckResourceAttr(resourceName, "secret", "second-secret"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccBitbucketHookImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func TestCreateHook_secret(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceHook().Schema, map[string]interface{}{
+		"owner":                  "example",
+		"repository":             "repo",
+		"url":                    "https://site.internal/",
+		"description":            "Test description",
+		"events":                 []interface{}{"repo:push"},
+		"skip_cert_verification": true,
+		"secret":                 "very-secret",
+	})
+
+	payload, err := json.Marshal(createHook(d))
+	if err != nil {
+		t.Fatalf("Failed to encode hook, %s", err)
+	}
+
+	if !strings.Contains(string(payload), `"secret":"very-secret"`) {
+		t.Errorf("Did not render secret: %s", payload)
+	}
+
+	if !strings.Contains(string(payload), `"skip_cert_verification":true`) {
+		t.Errorf("Did not render skip_cert_verification: %s", payload)
+	}
+}
+
 func TestEncodesJsonCompletely(t *testing.T) {
 	hook := &Hook{
 		UUID:        uuid.NewV4().String(),
@@ -97,6 +162,14 @@ func TestEncodesJsonCompletely(t *testing.T) {
 	if !strings.Contains(string(payload), `"skip_cert_verification":false`) {
 		t.Error("Did not render skip_cert_verification.")
 	}
+
+	if !strings.Contains(string(payload), `"history_enabled":false`) {
+		t.Error("Did not render history_enabled.")
+	}
+
+	if !strings.Contains(string(payload), `"secret":null`) {
+		t.Error("Did not render an unset secret.")
+	}
 }
 
 func testAccCheckBitbucketHookDestroy(s *terraform.State) error {
@@ -165,6 +238,7 @@ resource "bitbucket_hook" "test" {
   description            = "Test hook for terraform Updated"
   url                    = "https://httpbin.org"
   skip_cert_verification = true
+  history_enabled        = true
 
   events = [
   	"repo:push",
@@ -174,6 +248,27 @@ resource "bitbucket_hook" "test" {
 `, testUser, rName)
 }
 
+func testAccBitbucketHookSecretConfig(testUser, rName string, skipCertVerification bool, secret string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+resource "bitbucket_hook" "test" {
+  owner                  = %[1]q
+  repository             = bitbucket_repository.test.name
+  description            = "Test hook for terraform"
+  url                    = "https://httpbin.org"
+  skip_cert_verification = %[3]t
+  secret                 = %[4]q
+
+  events = [
+  	"repo:push",
+  ]
+}
+`, testUser, rName, skipCertVerification, secret)
+}
+
 func testAccBitbucketHookImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"regexp"
 	"strings"
 
 	"github.com/DrFaust92/bitbucket-go-client"
@@ -39,9 +40,10 @@ func resourcePipelineSchedule() *schema.Resource {
 				Required: true,
 			},
 			"cron_pattern": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePipelineCronPattern,
 			},
 			"target": {
 				Type:     schema.TypeList,
@@ -149,7 +151,7 @@ func resourcePipelineScheduleRead(ctx context.Context, d *schema.ResourceData, m
 
 	schedule, res, err := pipeApi.GetRepositoryPipelineSchedule(c.AuthContext, workspace, repo, uuid)
 
-	if res.StatusCode == http.StatusNotFound {
+	if res != nil && res.StatusCode == http.StatusNotFound {
 		log.Printf("[WARN] Pipeline Schedule (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -256,3 +258,24 @@ func pipeScheduleId(id string) (string, string, string, error) {
 
 	return parts[0], parts[1], parts[2], nil
 }
+
+var pipelineCronFieldRegexp = regexp.MustCompile(`^[0-9A-Za-z*?/,#-]+$`)
+
+// validatePipelineCronPattern checks the shape of the Quartz style cron
+// expressions used by pipeline schedules: seconds, minutes, hours, day of
+// month, month, day of week and an optional year.
+func validatePipelineCronPattern(v interface{}, k string) (ws []string, errs []error) {
+	fields := strings.Fields(v.(string))
+	if len(fields) != 6 && len(fields) != 7 {
+		errs = append(errs, fmt.Errorf("%q must have 6 or 7 space separated fields (seconds minutes hours day-of-month month day-of-week [year]), got %d", k, len(fields)))
+		return
+	}
+
+	for i, field := range fields {
+		if !pipelineCronFieldRegexp.MatchString(field) {
+			errs = append(errs, fmt.Errorf("%q has an invalid value %q in field %d", k, field, i+1))
+		}
+	}
+
+	return
+}
@@ -215,3 +215,17 @@ resource "bitbucket_pipeline_schedule" "test" {
 }
 `, workspace, repo, enabled)
 }
+
+func TestValidatePipelineCronPattern(t *testing.T) {
+	for _, pattern := range []string{"0 30 * * * ? *", "0 0 12 ? * MON-FRI", "0 0/15 * * * ? 2030"} {
+		if _, errs := validatePipelineCronPattern(pattern, "cron_pattern"); len(errs) != 0 {
+			t.Errorf("expected %q to be valid, got %v", pattern, errs)
+		}
+	}
+
+	for _, pattern := range []string{"", "* * * * *", "0 30 * * * ? * *", "0 30 * * * ? $"} {
+		if _, errs := validatePipelineCronPattern(pattern, "cron_pattern"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", pattern)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/DrFaust92/bitbucket-go-client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourcePipelineSshKey() *schema.Resource {
@@ -34,12 +35,16 @@ func resourcePipelineSshKey() *schema.Resource {
 				ForceNew: true,
 			},
 			"private_key": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			"public_key": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppressEquivalentSSHKeys,
 			},
 		},
 	}
@@ -75,7 +80,7 @@ func resourcePipelineSshKeysRead(ctx context.Context, d *schema.ResourceData, m
 
 	key, res, err := pipeApi.GetRepositoryPipelineSshKeyPair(c.AuthContext, workspace, repo)
 
-	if res.StatusCode == http.StatusNotFound {
+	if res != nil && res.StatusCode == http.StatusNotFound {
 		log.Printf("[WARN] Pipeline Ssh Key (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -88,6 +93,7 @@ func resourcePipelineSshKeysRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("repository", repo)
 	d.Set("workspace", workspace)
 	d.Set("public_key", key.PublicKey)
+	// The private key is write-only, keep the configured value.
 	d.Set("private_key", d.Get("private_key").(string))
 
 	return nil
@@ -62,6 +62,37 @@ func TestAccBitbucketPipelineSshKey_basic(t *testing.T) {
 	})
 }
 
+func TestResourcePipelineSshKeyValidate(t *testing.T) {
+	cases := map[string]struct {
+		raw   map[string]interface{}
+		valid bool
+	}{
+		"without keys": {
+			raw:   map[string]interface{}{"workspace": "example", "repository": "repo"},
+			valid: true,
+		},
+		"with keys": {
+			raw:   map[string]interface{}{"workspace": "example", "repository": "repo", "public_key": "ssh-rsa AAAA", "private_key": "secret"},
+			valid: true,
+		},
+		"blank public key": {
+			raw:   map[string]interface{}{"workspace": "example", "repository": "repo", "public_key": " "},
+			valid: false,
+		},
+		"blank private key": {
+			raw:   map[string]interface{}{"workspace": "example", "repository": "repo", "private_key": ""},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		diags := resourcePipelineSshKey().Validate(terraform.NewResourceConfigRaw(tc.raw))
+		if tc.valid == diags.HasError() {
+			t.Errorf("%s: expected valid=%t, got %v", name, tc.valid, diags)
+		}
+	}
+}
+
 func testAccCheckBitbucketPipelineSshKeyDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(Clients).genClient
 	pipeApi := client.ApiClient.PipelinesApi
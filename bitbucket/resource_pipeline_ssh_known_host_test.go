@@ -65,6 +65,35 @@ func TestAccBitbucketPipelineSshKnownHost_basic(t *testing.T) {
 	})
 }
 
+func TestResourcePipelineSshKnownHostValidate(t *testing.T) {
+	publicKey := []interface{}{map[string]interface{}{"key_type": "ssh-rsa", "key": "AAAA"}}
+
+	cases := map[string]struct {
+		raw   map[string]interface{}
+		valid bool
+	}{
+		"without hostname": {
+			raw:   map[string]interface{}{"workspace": "example", "repository": "repo", "public_key": publicKey},
+			valid: true,
+		},
+		"with hostname": {
+			raw:   map[string]interface{}{"workspace": "example", "repository": "repo", "hostname": "example.com", "public_key": publicKey},
+			valid: true,
+		},
+		"empty hostname": {
+			raw:   map[string]interface{}{"workspace": "example", "repository": "repo", "hostname": "", "public_key": publicKey},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		diags := resourcePipelineSshKnownHost().Validate(terraform.NewResourceConfigRaw(tc.raw))
+		if tc.valid == diags.HasError() {
+			t.Errorf("%s: expected valid=%t, got %v", name, tc.valid, diags)
+		}
+	}
+}
+
 func testAccCheckBitbucketPipelineSshKnownHostDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(Clients).genClient
 	pipeApi := client.ApiClient.PipelinesApi
@@ -106,17 +106,21 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	projectApi := c.ApiClient.ProjectsApi
 	project := newProjectFromResource(d)
 
-	var projectKey string
-	projectKey = d.Get("key").(string)
-	if projectKey == "" {
-		projectKey = d.Get("key").(string)
+	// The key can be changed in place, so address the project by the key it
+	// currently has rather than the configured one.
+	_, projectKey, err := projectId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	owner := d.Get("owner").(string)
 
-	_, _, err := projectApi.WorkspacesWorkspaceProjectsProjectKeyPut(c.AuthContext, *project, projectKey, d.Get("owner").(string))
+	projRes, _, err := projectApi.WorkspacesWorkspaceProjectsProjectKeyPut(c.AuthContext, *project, projectKey, owner)
 	if err := handleClientError(err); err != nil {
 		return diag.FromErr(err)
 	}
 
+	d.SetId(string(fmt.Sprintf("%s/%s", owner, projRes.Key)))
+
 	return resourceProjectRead(ctx, d, m)
 }
 
@@ -166,7 +170,7 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	projRes, res, err := projectApi.WorkspacesWorkspaceProjectsProjectKeyGet(c.AuthContext, projectKey, d.Get("owner").(string))
 
-	if res.StatusCode == http.StatusNotFound {
+	if res != nil && res.StatusCode == http.StatusNotFound {
 		log.Printf("[WARN] Project (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -237,3 +241,13 @@ func flattenProjectLinks(rp *bitbucket.ProjectLinks) []interface{} {
 
 	return []interface{}{m}
 }
+
+func projectId(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected OWNER/PROJECT-KEY", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,68 @@
+package bitbucket
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Project tokens cover every repository in the project, on top of the
+// repository scopes they can administer the project itself.
+var projectAccessTokenScopes = append([]string{"project", "project:admin"}, repositoryAccessTokenScopes...)
+
+var projectAccessTokenOwner = accessTokenOwner{
+	kind:     "Project Access Token",
+	keys:     []string{"workspace", "project_key"},
+	idFormat: "WORKSPACE/PROJECT-KEY/TOKEN-ID",
+	endpoint: func(keys []string) string {
+		return fmt.Sprintf("2.0/workspaces/%s/projects/%s/access-tokens", keys[0], keys[1])
+	},
+}
+
+func resourceProjectAccessToken() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: projectAccessTokenOwner.create,
+		ReadWithoutTimeout:   projectAccessTokenOwner.read,
+		UpdateWithoutTimeout: projectAccessTokenOwner.update,
+		DeleteWithoutTimeout: projectAccessTokenOwner.delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"scopes": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(projectAccessTokenScopes, false),
+				},
+			},
+			"token_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
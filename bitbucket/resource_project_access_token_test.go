@@ -0,0 +1,104 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBitbucketProjectAccessToken_basic(t *testing.T) {
+	resourceName := "bitbucket_project_access_token.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketProjectAccessTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketProjectAccessTokenConfig(workspace, rName, rName, "repository"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketProjectAccessTokenExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "workspace", workspace),
+					resource.TestCheckResourceAttrPair(resourceName, "project_key", "bitbucket_project.test", "key"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "repository"),
+					resource.TestCheckResourceAttrSet(resourceName, "token_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+				),
+			},
+			{
+				Config: testAccBitbucketProjectAccessTokenConfig(workspace, rName, rName+"-updated", "project"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketProjectAccessTokenExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "project"),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func testAccCheckBitbucketProjectAccessTokenDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(Clients).httpClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bitbucket_project_access_token" {
+			continue
+		}
+
+		_, err := client.Get(fmt.Sprintf("2.0/workspaces/%s/projects/%s/access-tokens/%s", rs.Primary.Attributes["workspace"], rs.Primary.Attributes["project_key"], rs.Primary.Attributes["token_id"]))
+
+		if err == nil {
+			return fmt.Errorf("The resource was found should have errored")
+		}
+
+		if !IsNotFound(err) {
+			return fmt.Errorf("Project Access Token still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckBitbucketProjectAccessTokenExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Project Access Token ID is set")
+		}
+		return nil
+	}
+}
+
+func testAccBitbucketProjectAccessTokenConfig(workspace, rName, tokenName, scope string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_project" "test" {
+  owner = %[1]q
+  name  = %[2]q
+  key   = "DDDDDDDD"
+}
+
+resource "bitbucket_project_access_token" "test" {
+  workspace   = %[1]q
+  project_key = bitbucket_project.test.key
+  name        = %[3]q
+  scopes      = [%[4]q]
+}
+`, workspace, rName, tokenName, scope)
+}
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -160,14 +159,18 @@ func resourceProjectBranchingModelsRead(ctx context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	branchingModelsReq, _ := client.Get(fmt.Sprintf("2.0/workspaces/%s/projects/%s/branching-model", workspace, repo))
+	branchingModelsReq, err := client.Get(fmt.Sprintf("2.0/workspaces/%s/projects/%s/branching-model", workspace, repo))
 
-	if branchingModelsReq.StatusCode == http.StatusNotFound {
+	if IsNotFound(err) {
 		log.Printf("[WARN] Project Branching Model (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if branchingModelsReq.Body == nil {
 		return diag.Errorf("error getting Project Branching Model (%s): empty response", d.Id())
 	}
@@ -204,12 +207,13 @@ func resourceProjectBranchingModelsDelete(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	_, err = client.Put(fmt.Sprintf("2.0/workspaces/%s/projects/%s/branching-model/settings", workspace, repo), nil)
-
+	payload, err := defaultBranchingModelSettings()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	_, err = client.Put(fmt.Sprintf("2.0/workspaces/%s/projects/%s/branching-model/settings", workspace, repo), payload)
+
 	return diag.FromErr(err)
 }
 
@@ -5,7 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/DrFaust92/bitbucket-go-client"
@@ -44,21 +44,25 @@ func resourceProjectDefaultReviewers() *schema.Resource {
 }
 
 func resourceProjectDefaultReviewersCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-	projectsApi := c.ApiClient.ProjectsApi
+	client := m.(Clients).httpClient
 
 	workspace := d.Get("workspace").(string)
 	project := d.Get("project").(string)
 
+	// Reviewers are added one request at a time, keep the ones that made it
+	// in state so a failed apply doesn't leave them unmanaged.
+	d.SetId(fmt.Sprintf("%s/%s", workspace, project))
+
+	var applied []string
 	for _, user := range d.Get("reviewers").(*schema.Set).List() {
 		userName := user.(string)
-		_, _, err := projectsApi.WorkspacesWorkspaceProjectsProjectKeyDefaultReviewersSelectedUserPut(c.AuthContext, project, userName, workspace)
-		if err := handleClientError(err); err != nil {
+		if err := putProjectDefaultReviewer(client, workspace, project, userName); err != nil {
+			d.Set("reviewers", applied)
 			return diag.FromErr(err)
 		}
+		applied = append(applied, userName)
 	}
 
-	d.SetId(fmt.Sprintf("%s/%s", workspace, project))
 	return resourceProjectDefaultReviewersRead(ctx, d, m)
 }
 
@@ -70,45 +74,28 @@ func resourceProjectDefaultReviewersRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	resourceURL := fmt.Sprintf("2.0/workspaces/%s/projects/%s/default-reviewers", workspace, project)
+	var terraformReviewers []string
+	err = client.GetPaged(fmt.Sprintf("2.0/workspaces/%s/projects/%s/default-reviewers", workspace, project), func(raw json.RawMessage) error {
+		var reviewer bitbucket.DefaultReviewerAndType
+		if err := json.Unmarshal(raw, &reviewer); err != nil {
+			return err
+		}
 
-	res, err := client.Get(resourceURL)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+		if reviewer.User != nil {
+			terraformReviewers = append(terraformReviewers, reviewer.User.Uuid)
+		}
 
-	if res.StatusCode == http.StatusNotFound {
+		return nil
+	})
+
+	if IsNotFound(err) {
 		log.Printf("[WARN] Project Default Reviewers (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	var reviewers bitbucket.PaginatedDefaultReviewerAndType
-	var terraformReviewers []string
-
-	for {
-		reviewersResponse, err := client.Get(resourceURL)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		decoder := json.NewDecoder(reviewersResponse.Body)
-		err = decoder.Decode(&reviewers)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		for _, reviewer := range reviewers.Values {
-			terraformReviewers = append(terraformReviewers, reviewer.User.Uuid)
-		}
-
-		if reviewers.Next != "" {
-			nextPage := reviewers.Page + 1
-			resourceURL = fmt.Sprintf("2.0/workspaces/%s/projects/%s/default-reviewers?page=%d", workspace, project, nextPage)
-			reviewers = bitbucket.PaginatedDefaultReviewerAndType{}
-		} else {
-			break
-		}
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.Set("workspace", workspace)
@@ -119,9 +106,8 @@ func resourceProjectDefaultReviewersRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceProjectDefaultReviewersUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
+	client := m.(Clients).httpClient
 
-	projectsApi := c.ApiClient.ProjectsApi
 	oraw, nraw := d.GetChange("reviewers")
 	o := oraw.(*schema.Set)
 	n := nraw.(*schema.Set)
@@ -131,38 +117,61 @@ func resourceProjectDefaultReviewersUpdate(ctx context.Context, d *schema.Resour
 	project := d.Get("project").(string)
 	workspace := d.Get("workspace").(string)
 
+	// Start from the previous reviewers and track every change that went
+	// through, on failure that is what ends up in state.
+	applied := schema.NewSet(schema.HashString, o.List())
+
 	for _, user := range add.List() {
 		userName := user.(string)
-		_, _, err := projectsApi.WorkspacesWorkspaceProjectsProjectKeyDefaultReviewersSelectedUserPut(c.AuthContext, project, userName, workspace)
-		if err := handleClientError(err); err != nil {
+		if err := putProjectDefaultReviewer(client, workspace, project, userName); err != nil {
+			d.Set("reviewers", applied)
 			return diag.FromErr(err)
 		}
+		applied.Add(userName)
 	}
 
 	for _, user := range remove.List() {
 		userName := user.(string)
-		_, err := projectsApi.WorkspacesWorkspaceProjectsProjectKeyDefaultReviewersSelectedUserDelete(c.AuthContext, project, userName, workspace)
-		if err := handleClientError(err); err != nil {
+		if err := deleteProjectDefaultReviewer(client, workspace, project, userName); err != nil {
+			d.Set("reviewers", applied)
 			return diag.FromErr(err)
 		}
+		applied.Remove(userName)
 	}
 
 	return resourceProjectDefaultReviewersRead(ctx, d, m)
 }
 
 func resourceProjectDefaultReviewersDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-	projectsApi := c.ApiClient.ProjectsApi
+	client := m.(Clients).httpClient
 
 	project := d.Get("project").(string)
 	workspace := d.Get("workspace").(string)
+
 	for _, user := range d.Get("reviewers").(*schema.Set).List() {
-		userName := user.(string)
-		_, err := projectsApi.WorkspacesWorkspaceProjectsProjectKeyDefaultReviewersSelectedUserDelete(c.AuthContext, project, userName, workspace)
-		if err := handleClientError(err); err != nil {
+		if err := deleteProjectDefaultReviewer(client, workspace, project, user.(string)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
+
+	return nil
+}
+
+func putProjectDefaultReviewer(client *Client, workspace, project, user string) error {
+	_, err := client.PutOnly(fmt.Sprintf("2.0/workspaces/%s/projects/%s/default-reviewers/%s", workspace, project, url.PathEscape(user)))
+	if err != nil {
+		return fmt.Errorf("error adding default reviewer %s to project %s: %w", user, project, err)
+	}
+
+	return nil
+}
+
+func deleteProjectDefaultReviewer(client *Client, workspace, project, user string) error {
+	_, err := client.Delete(fmt.Sprintf("2.0/workspaces/%s/projects/%s/default-reviewers/%s", workspace, project, url.PathEscape(user)))
+	if err != nil && !IsNotFound(err) {
+		return fmt.Errorf("error removing default reviewer %s from project %s: %w", user, project, err)
+	}
+
 	return nil
 }
 
@@ -1,13 +1,16 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -87,3 +90,44 @@ func testAccCheckBitbucketProjectDefaultReviewersExists(n string) resource.TestC
 		return nil
 	}
 }
+
+func TestResourceProjectDefaultReviewersCreate_partialFailure(t *testing.T) {
+	var added []string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		user := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		if user == "{bad}" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":{"message":"bad reviewer"}}`)
+			return
+		}
+
+		added = append(added, user)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceProjectDefaultReviewers().Schema, map[string]interface{}{
+		"workspace": "example",
+		"project":   "PROJ",
+		"reviewers": []interface{}{"{one}", "{two}", "{bad}"},
+	})
+
+	diags := resourceProjectDefaultReviewersCreate(context.Background(), d, Clients{httpClient: client})
+	if !diags.HasError() {
+		t.Fatal("expected an error")
+	}
+
+	if d.Id() != "example/PROJ" {
+		t.Errorf("expected ID to be set, got %q", d.Id())
+	}
+
+	reviewers := d.Get("reviewers").(*schema.Set)
+	if reviewers.Len() != len(added) {
+		t.Fatalf("expected %d reviewers in state, got %d", len(added), reviewers.Len())
+	}
+
+	for _, user := range added {
+		if !reviewers.Contains(user) {
+			t.Errorf("expected %s to be tracked in state", user)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type ProjectGroupPermission struct {
+	Permission string           `json:"permission"`
+	Group      *RepositoryGroup `json:"group,omitempty"`
+}
+
+func resourceProjectGroupPermission() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProjectGroupPermissionPut,
+		ReadWithoutTimeout:   resourceProjectGroupPermissionRead,
+		UpdateWithoutTimeout: resourceProjectGroupPermissionPut,
+		DeleteWithoutTimeout: resourceProjectGroupPermissionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_slug": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"admin", "create-repo", "write", "read"}, false),
+			},
+		},
+	}
+}
+
+func resourceProjectGroupPermissionPut(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+	permission := &ProjectGroupPermission{
+		Permission: d.Get("permission").(string),
+	}
+
+	payload, err := json.Marshal(permission)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	workspace := d.Get("workspace").(string)
+	projectKey := d.Get("project_key").(string)
+	groupSlug := d.Get("group_slug").(string)
+
+	err = client.DoAndDecode(http.MethodPut, fmt.Sprintf("2.0/workspaces/%s/projects/%s/permissions-config/groups/%s",
+		workspace,
+		projectKey,
+		groupSlug,
+	), bytes.NewBuffer(payload), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(fmt.Sprintf("%s/%s/%s", workspace, projectKey, groupSlug))
+	}
+
+	return resourceProjectGroupPermissionRead(ctx, d, m)
+}
+
+func resourceProjectGroupPermissionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, projectKey, groupSlug, err := projectGroupPermissionId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var permission ProjectGroupPermission
+	err = client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/workspaces/%s/projects/%s/permissions-config/groups/%s",
+		workspace,
+		projectKey,
+		groupSlug,
+	), nil, &permission)
+
+	if IsNotFound(err) {
+		log.Printf("[WARN] Project Group Permission (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Project Group Permission decoded: %#v", permission)
+
+	d.Set("workspace", workspace)
+	d.Set("project_key", projectKey)
+	d.Set("group_slug", groupSlug)
+	d.Set("permission", permission.Permission)
+
+	return nil
+}
+
+func resourceProjectGroupPermissionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, projectKey, groupSlug, err := projectGroupPermissionId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Delete(fmt.Sprintf("2.0/workspaces/%s/projects/%s/permissions-config/groups/%s",
+		workspace,
+		projectKey,
+		groupSlug,
+	))
+
+	return diag.FromErr(err)
+}
+
+func projectGroupPermissionId(id string) (string, string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/PROJECT-KEY/GROUP-SLUG", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
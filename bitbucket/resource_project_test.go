@@ -46,6 +46,36 @@ func TestAccBitbucketProject_basic(t *testing.T) {
 	})
 }
 
+func TestAccBitbucketProject_key(t *testing.T) {
+	resourceName := "bitbucket_project.test"
+	testTeam := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketProjectKeyConfig(testTeam, rName, "CCCCC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", "CCCCC"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/CCCCC", testTeam)),
+				),
+			},
+			{
+				Config: testAccBitbucketProjectKeyConfig(testTeam, rName, "DDDDD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", "DDDDD"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/DDDDD", testTeam)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBitbucketProject_avatar(t *testing.T) {
 	resourceName := "bitbucket_project.test"
 	testTeam := os.Getenv("BITBUCKET_TEAM")
@@ -84,6 +114,16 @@ resource "bitbucket_project" "test" {
 `, team, rName)
 }
 
+func testAccBitbucketProjectKeyConfig(team, rName, key string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_project" "test" {
+  owner = %[1]q
+  name  = %[2]q
+  key   = %[3]q
+}
+`, team, rName, key)
+}
+
 func testAccBitbucketProjectAvatarConfig(team, rName string) string {
 	return fmt.Sprintf(`
 resource "bitbucket_project" "test" {
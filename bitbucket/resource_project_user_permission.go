@@ -0,0 +1,150 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type ProjectUserPermission struct {
+	Permission string          `json:"permission"`
+	User       *RepositoryUser `json:"user,omitempty"`
+}
+
+func resourceProjectUserPermission() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProjectUserPermissionPut,
+		ReadWithoutTimeout:   resourceProjectUserPermissionRead,
+		UpdateWithoutTimeout: resourceProjectUserPermissionPut,
+		DeleteWithoutTimeout: resourceProjectUserPermissionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"admin", "create-repo", "write", "read"}, false),
+			},
+		},
+	}
+}
+
+func resourceProjectUserPermissionPut(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+	permission := &ProjectUserPermission{
+		Permission: d.Get("permission").(string),
+	}
+
+	payload, err := json.Marshal(permission)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	workspace := d.Get("workspace").(string)
+	projectKey := d.Get("project_key").(string)
+	userId := d.Get("user_id").(string)
+
+	err = client.DoAndDecode(http.MethodPut, fmt.Sprintf("2.0/workspaces/%s/projects/%s/permissions-config/users/%s",
+		workspace,
+		projectKey,
+		userId,
+	), bytes.NewBuffer(payload), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(fmt.Sprintf("%s/%s/%s", workspace, projectKey, userId))
+	}
+
+	return resourceProjectUserPermissionRead(ctx, d, m)
+}
+
+func resourceProjectUserPermissionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, projectKey, userId, err := projectUserPermissionId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var permission ProjectUserPermission
+	err = client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/workspaces/%s/projects/%s/permissions-config/users/%s",
+		workspace,
+		projectKey,
+		userId,
+	), nil, &permission)
+
+	// A user that left the workspace has no permission entry either.
+	if IsNotFound(err) {
+		log.Printf("[WARN] Project User Permission (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Project User Permission decoded: %#v", permission)
+
+	d.Set("workspace", workspace)
+	d.Set("project_key", projectKey)
+	d.Set("user_id", userId)
+	d.Set("permission", permission.Permission)
+
+	return nil
+}
+
+func resourceProjectUserPermissionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, projectKey, userId, err := projectUserPermissionId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Delete(fmt.Sprintf("2.0/workspaces/%s/projects/%s/permissions-config/users/%s",
+		workspace,
+		projectKey,
+		userId,
+	))
+
+	return diag.FromErr(err)
+}
+
+func projectUserPermissionId(id string) (string, string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/PROJECT-KEY/USER-ID", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
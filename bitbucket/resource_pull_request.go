@@ -0,0 +1,272 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// PullRequest is a pull request between two branches of a repository
+type PullRequest struct {
+	ID                int                  `json:"id,omitempty"`
+	Title             string               `json:"title"`
+	Description       string               `json:"description"`
+	State             string               `json:"state,omitempty"`
+	Source            *PullRequestEndpoint `json:"source,omitempty"`
+	Destination       *PullRequestEndpoint `json:"destination,omitempty"`
+	CloseSourceBranch bool                 `json:"close_source_branch"`
+	Reviewers         []User               `json:"reviewers"`
+}
+
+// PullRequestEndpoint is the source or destination of a pull request
+type PullRequestEndpoint struct {
+	Branch *PullRequestBranch `json:"branch,omitempty"`
+}
+
+// PullRequestBranch is the branch of a pull request endpoint
+type PullRequestBranch struct {
+	Name string `json:"name"`
+}
+
+func resourcePullRequest() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePullRequestCreate,
+		ReadWithoutTimeout:   resourcePullRequestRead,
+		UpdateWithoutTimeout: resourcePullRequestUpdate,
+		DeleteWithoutTimeout: resourcePullRequestDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: suppressClosedPullRequestDiff,
+			},
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressClosedPullRequestDiff,
+			},
+			"source_branch": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"destination_branch": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressClosedPullRequestDiff,
+			},
+			"close_source_branch": {
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          false,
+				DiffSuppressFunc: suppressClosedPullRequestDiff,
+			},
+			"reviewers": {
+				Type:             schema.TypeSet,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				Optional:         true,
+				DiffSuppressFunc: suppressClosedPullRequestDiff,
+			},
+			"pull_request_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// suppressClosedPullRequestDiff ignores changes to merged and declined pull
+// requests, Bitbucket doesn't allow updating them anymore so they are kept in
+// state as they were closed.
+func suppressClosedPullRequestDiff(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+
+	state := d.Get("state").(string)
+	return state != "" && state != "OPEN"
+}
+
+func expandPullRequest(d *schema.ResourceData) *PullRequest {
+	pr := &PullRequest{
+		Title:             d.Get("title").(string),
+		Description:       d.Get("description").(string),
+		CloseSourceBranch: d.Get("close_source_branch").(bool),
+		Source: &PullRequestEndpoint{
+			Branch: &PullRequestBranch{Name: d.Get("source_branch").(string)},
+		},
+		Reviewers: make([]User, 0, d.Get("reviewers").(*schema.Set).Len()),
+	}
+
+	// Bitbucket targets the main branch of the repository when there is no
+	// destination.
+	if v, ok := d.GetOk("destination_branch"); ok {
+		pr.Destination = &PullRequestEndpoint{
+			Branch: &PullRequestBranch{Name: v.(string)},
+		}
+	}
+
+	for _, reviewer := range d.Get("reviewers").(*schema.Set).List() {
+		pr.Reviewers = append(pr.Reviewers, User{UUID: reviewer.(string)})
+	}
+
+	return pr
+}
+
+func resourcePullRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+
+	bytedata, err := json.Marshal(expandPullRequest(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var pr PullRequest
+	err = client.DoAndDecode(http.MethodPost, fmt.Sprintf("2.0/repositories/%s/%s/pullrequests", workspace, repo), bytes.NewBuffer(bytedata), &pr)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", workspace, repo, pr.ID))
+
+	return resourcePullRequestRead(ctx, d, m)
+}
+
+func resourcePullRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repo, prID, err := pullRequestId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var pr PullRequest
+	err = client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/repositories/%s/%s/pullrequests/%d", workspace, repo, prID), nil, &pr)
+
+	if IsNotFound(err) {
+		log.Printf("[WARN] Pull Request (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("workspace", workspace)
+	d.Set("repository", repo)
+	d.Set("pull_request_id", pr.ID)
+	d.Set("title", pr.Title)
+	d.Set("description", pr.Description)
+	d.Set("close_source_branch", pr.CloseSourceBranch)
+	d.Set("state", pr.State)
+
+	if pr.Source != nil && pr.Source.Branch != nil {
+		d.Set("source_branch", pr.Source.Branch.Name)
+	}
+
+	if pr.Destination != nil && pr.Destination.Branch != nil {
+		d.Set("destination_branch", pr.Destination.Branch.Name)
+	}
+
+	reviewers := make([]string, 0, len(pr.Reviewers))
+	for _, reviewer := range pr.Reviewers {
+		reviewers = append(reviewers, reviewer.UUID)
+	}
+	d.Set("reviewers", reviewers)
+
+	return nil
+}
+
+func resourcePullRequestUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repo, prID, err := pullRequestId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bytedata, err := json.Marshal(expandPullRequest(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := client.Put(fmt.Sprintf("2.0/repositories/%s/%s/pullrequests/%d", workspace, repo, prID), bytes.NewBuffer(bytedata))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res.Body.Close()
+
+	return resourcePullRequestRead(ctx, d, m)
+}
+
+func resourcePullRequestDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repo, prID, err := pullRequestId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Pull requests can't be deleted, the closest is declining them.
+	if state := d.Get("state").(string); state != "OPEN" {
+		log.Printf("[DEBUG] Pull Request (%s) is %s, not declining it", d.Id(), state)
+		return nil
+	}
+
+	res, err := client.Post(fmt.Sprintf("2.0/repositories/%s/%s/pullrequests/%d/decline", workspace, repo, prID), nil)
+	if IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res.Body.Close()
+
+	return nil
+}
+
+func pullRequestId(id string) (string, string, int, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" {
+		if prID, err := strconv.Atoi(parts[2]); err == nil {
+			return parts[0], parts[1], prID, nil
+		}
+	}
+
+	return "", "", 0, fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/REPO-SLUG/PULL-REQUEST-ID", id)
+}
@@ -0,0 +1,202 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBitbucketPullRequest_basic(t *testing.T) {
+	resourceName := "bitbucket_pull_request.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	// pull requests need commits on both branches, so an existing repository is used
+	repo := os.Getenv("BITBUCKET_PIPELINED_REPO")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckPipeSchedule(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketPullRequestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketPullRequestConfig(workspace, repo, rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketPullRequestExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "title", rName+" first"),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by terraform"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_branch", "bitbucket_branch.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "destination_branch"),
+					resource.TestCheckResourceAttr(resourceName, "close_source_branch", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", "OPEN"),
+					resource.TestCheckResourceAttrSet(resourceName, "pull_request_id"),
+				),
+			},
+			{
+				Config: testAccBitbucketPullRequestConfig(workspace, repo, rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketPullRequestExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "title", rName+" second"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBitbucketPullRequestDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(Clients).httpClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bitbucket_pull_request" {
+			continue
+		}
+
+		var pr PullRequest
+		err := client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/repositories/%s/%s/pullrequests/%s", rs.Primary.Attributes["workspace"], rs.Primary.Attributes["repository"], rs.Primary.Attributes["pull_request_id"]), nil, &pr)
+
+		if IsNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if pr.State != "DECLINED" {
+			return fmt.Errorf("Pull Request is still %s", pr.State)
+		}
+	}
+	return nil
+}
+
+func testAccCheckBitbucketPullRequestExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pull Request ID is set")
+		}
+		return nil
+	}
+}
+
+func testAccBitbucketPullRequestConfig(workspace, repo, rName, title string) string {
+	return fmt.Sprintf(`
+data "bitbucket_repository" "test" {
+  workspace = %[1]q
+  slug      = %[2]q
+}
+
+resource "bitbucket_branch" "test" {
+  workspace  = %[1]q
+  repository = %[2]q
+  name       = %[3]q
+  target     = data.bitbucket_repository.test.mainbranch
+}
+
+resource "bitbucket_commit_file" "test" {
+  workspace      = %[1]q
+  repository     = %[2]q
+  branch         = bitbucket_branch.test.name
+  path           = "%[3]s.txt"
+  content        = "pull request"
+  commit_message = "add a change to review"
+}
+
+resource "bitbucket_pull_request" "test" {
+  workspace           = %[1]q
+  repository          = %[2]q
+  title               = "%[3]s %[4]s"
+  description         = "created by terraform"
+  source_branch       = bitbucket_commit_file.test.branch
+  close_source_branch = true
+}
+`, workspace, repo, rName, title)
+}
+
+func TestResourcePullRequestDelete(t *testing.T) {
+	for state, declined := range map[string]bool{"OPEN": true, "MERGED": false, "DECLINED": false} {
+		var requests []string
+		client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			fmt.Fprint(w, `{}`)
+		})
+
+		d := schema.TestResourceDataRaw(t, resourcePullRequest().Schema, map[string]interface{}{
+			"workspace":     "example",
+			"repository":    "repo",
+			"title":         "title",
+			"source_branch": "feature",
+			"state":         state,
+		})
+		d.SetId("example/repo/3")
+
+		if diags := resourcePullRequestDelete(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if declined && (len(requests) != 1 || requests[0] != "POST /2.0/repositories/example/repo/pullrequests/3/decline") {
+			t.Errorf("expected an %s pull request to be declined, got %v", state, requests)
+		}
+
+		if !declined && len(requests) != 0 {
+			t.Errorf("expected a %s pull request to be left alone, got %v", state, requests)
+		}
+	}
+}
+
+func TestResourcePullRequestDiff_closed(t *testing.T) {
+	for state, ignored := range map[string]bool{"OPEN": false, "MERGED": true, "DECLINED": true} {
+		current := &terraform.InstanceState{
+			ID: "example/repo/3",
+			Attributes: map[string]string{
+				"id":                  "example/repo/3",
+				"workspace":           "example",
+				"repository":          "repo",
+				"title":               "title",
+				"description":         "",
+				"source_branch":       "feature",
+				"destination_branch":  "main",
+				"close_source_branch": "false",
+				"reviewers.#":         "0",
+				"pull_request_id":     "3",
+				"state":               state,
+			},
+		}
+
+		diff, err := resourcePullRequest().Diff(context.Background(), current, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"workspace":     "example",
+			"repository":    "repo",
+			"title":         "new title",
+			"description":   "new description",
+			"source_branch": "feature",
+			"reviewers":     []interface{}{"{4a1f40d2-7a04-4f3c-9d1a-3a2b3d1f6e7c}"},
+		}), nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if ignored && diff != nil && len(diff.Attributes) != 0 {
+			t.Errorf("expected the changes to a %s pull request to be ignored, got %v", state, diff.Attributes)
+		}
+
+		if !ignored && (diff == nil || diff.Attributes["title"] == nil || diff.Attributes["description"] == nil) {
+			t.Errorf("expected the changes to an %s pull request to be planned, got %v", state, diff)
+		}
+	}
+}
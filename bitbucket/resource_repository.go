@@ -8,12 +8,15 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/DrFaust92/bitbucket-go-client"
 	"github.com/antihax/optional"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -27,6 +30,9 @@ func resourceRepository() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"scm": {
 				Type:         schema.TypeString,
@@ -45,8 +51,9 @@ func resourceRepository() *schema.Resource {
 				Default:  false,
 			},
 			"website": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 			},
 			"clone_ssh": {
 				Type:     schema.TypeString,
@@ -78,12 +85,18 @@ func resourceRepository() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"allow_forks", "no_public_forks", "no_forks"}, false),
 			},
 			"language": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressCaseDifferences,
+			},
+			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"description": {
+			"main_branch": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"owner": {
 				Type:     schema.TypeString,
@@ -150,6 +163,13 @@ type RepositoryInheritanceSettings struct {
 	BranchingModel       *bool `json:"branching_model,omitempty"`
 }
 
+// RepositoryWithWebsite is a repository along with its website, which the
+// generated client doesn't model.
+type RepositoryWithWebsite struct {
+	bitbucket.Repository
+	Website string `json:"website,omitempty"`
+}
+
 func newRepositoryFromResource(d *schema.ResourceData) *bitbucket.Repository {
 	repo := &bitbucket.Repository{
 		Name:        d.Get("name").(string),
@@ -176,28 +196,85 @@ func newRepositoryFromResource(d *schema.ResourceData) *bitbucket.Repository {
 	return repo
 }
 
+// expandRepositoryChanges builds a payload of only the repository attributes
+// that changed, so fields Bitbucket manages but Terraform doesn't model are
+// left alone.
+func expandRepositoryChanges(d *schema.ResourceData) map[string]interface{} {
+	changes := make(map[string]interface{})
+
+	for _, k := range []string{"name", "slug", "language", "is_private", "description", "fork_policy", "has_wiki", "has_issues", "website", "scm"} {
+		if d.HasChange(k) {
+			changes[k] = d.Get(k)
+		}
+	}
+
+	if d.HasChange("project_key") {
+		if v, ok := d.GetOk("project_key"); ok && v.(string) != "" {
+			changes["project"] = &bitbucket.Project{Key: v.(string)}
+		}
+	}
+
+	if d.HasChange("link") {
+		if links := expandLinks(d.Get("link").([]interface{})); links != nil {
+			changes["links"] = links
+		}
+	}
+
+	return changes
+}
+
+// patchRepository sends the changed attributes of the repository, it does
+// nothing when none of them changed. It returns the slug of the repository
+// afterwards, as Bitbucket can assign a new one when it is renamed.
+func patchRepository(client *Client, workspace, repoSlug string, d *schema.ResourceData) (string, error) {
+	changes := expandRepositoryChanges(d)
+	if len(changes) == 0 {
+		return repoSlug, nil
+	}
+
+	payload, err := json.Marshal(changes)
+	if err != nil {
+		return "", err
+	}
+
+	var repo RepositoryWithWebsite
+	err = client.DoAndDecode(http.MethodPatch, fmt.Sprintf("2.0/repositories/%s/%s", workspace, repoSlug), bytes.NewBuffer(payload), &repo)
+	if err != nil {
+		return "", err
+	}
+
+	if repo.Slug == "" {
+		return repoSlug, nil
+	}
+
+	return repo.Slug, nil
+}
+
 func resourceRepositoryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(Clients).genClient
-	repoApi := c.ApiClient.RepositoriesApi
 	pipeApi := c.ApiClient.PipelinesApi
 	client := m.(Clients).httpClient
 
-	var repoSlug string
-	repoSlug = d.Get("slug").(string)
-	if repoSlug == "" {
-		repoSlug = d.Get("name").(string)
+	// The configured slug can differ from the actual one, the ID is what
+	// the repository was last seen as.
+	workspace, repoSlug, err := repositoryId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	repoSlug = computeSlug(repoSlug)
-	workspace := d.Get("owner").(string)
 
-	if d.HasChangesExcept("pipelines_enabled", "inherit_default_merge_strategy", "inherit_branching_model") {
-		repository := newRepositoryFromResource(d)
+	updatedSlug, err := patchRepository(client, workspace, repoSlug, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-		repoBody := &bitbucket.RepositoriesApiRepositoriesWorkspaceRepoSlugPutOpts{
-			Body: optional.NewInterface(repository),
-		}
-		_, _, err := repoApi.RepositoriesWorkspaceRepoSlugPut(c.AuthContext, repoSlug, workspace, repoBody)
-		if err := handleClientError(err); err != nil {
+	if updatedSlug != repoSlug {
+		log.Printf("[DEBUG] Repository (%s) now has the slug %s", d.Id(), updatedSlug)
+		repoSlug = updatedSlug
+		d.SetId(fmt.Sprintf("%s/%s", workspace, repoSlug))
+	}
+
+	if d.HasChange("main_branch") {
+		if err := setRepositoryMainBranch(client, workspace, repoSlug, d.Get("main_branch").(string)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -245,7 +322,10 @@ func resourceRepositoryCreate(ctx context.Context, d *schema.ResourceData, m int
 	pipeApi := c.ApiClient.PipelinesApi
 	client := m.(Clients).httpClient
 
-	repo := newRepositoryFromResource(d)
+	repo := &RepositoryWithWebsite{
+		Repository: *newRepositoryFromResource(d),
+		Website:    d.Get("website").(string),
+	}
 
 	var repoSlug string
 	repoSlug = d.Get("slug").(string)
@@ -267,6 +347,18 @@ func resourceRepositoryCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	d.SetId(string(fmt.Sprintf("%s/%s", d.Get("owner").(string), repoSlug)))
 
+	// Bitbucket provisions repositories asynchronously, the settings below
+	// and dependent resources 404 until it is done.
+	if err := waitForRepository(ctx, client, workspace, repoSlug, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if v, ok := d.GetOk("main_branch"); ok {
+		if err := setRepositoryMainBranch(client, workspace, repoSlug, v.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// nolint:staticcheck
 	if v, ok := d.GetOkExists("pipelines_enabled"); ok {
 		pipelinesConfig := &bitbucket.PipelinesConfig{Enabled: v.(bool)}
@@ -304,9 +396,74 @@ func resourceRepositoryCreate(ctx context.Context, d *schema.ResourceData, m int
 	return resourceRepositoryRead(ctx, d, m)
 }
 
+// repositoryPollInterval is the delay between checks of waitForRepository,
+// it is fixed whatever the timeout and documented as such.
+var repositoryPollInterval = 2 * time.Second
+
+// waitForRepository polls until a repository that is created asynchronously,
+// like a fork, can be read.
+func waitForRepository(ctx context.Context, client *Client, workspace, repoSlug string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"pending"},
+		Target:       []string{"ready"},
+		Timeout:      timeout,
+		PollInterval: repositoryPollInterval,
+		Refresh: func() (interface{}, string, error) {
+			res, err := client.Get(fmt.Sprintf("2.0/repositories/%s/%s", workspace, repoSlug))
+			if IsNotFound(err) {
+				log.Printf("[DEBUG] Repository %s/%s is not ready yet", workspace, repoSlug)
+				return repoSlug, "pending", nil
+			}
+
+			if err != nil {
+				return nil, "", err
+			}
+			res.Body.Close()
+
+			return repoSlug, "ready", nil
+		},
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for repository %s/%s to be ready: %w", workspace, repoSlug, err)
+	}
+
+	return nil
+}
+
+// setRepositoryMainBranch makes an existing branch the main branch of the
+// repository. Bitbucket rejects unknown branches with a bare 400, so check
+// for the branch first to be able to tell what is wrong.
+func setRepositoryMainBranch(client *Client, workspace, repoSlug, branch string) error {
+	res, err := client.Get(fmt.Sprintf("2.0/repositories/%s/%s/refs/branches/%s", workspace, repoSlug, url.PathEscape(branch)))
+	if IsNotFound(err) {
+		return fmt.Errorf("branch %q does not exist in repository %s/%s, it has to be pushed before it can be the main branch", branch, workspace, repoSlug)
+	}
+
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"mainbranch": map[string]string{"name": branch},
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err = client.Put(fmt.Sprintf("2.0/repositories/%s/%s", workspace, repoSlug), bytes.NewBuffer(payload))
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+
+	return nil
+}
+
 func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(Clients).genClient
-	repoApi := c.ApiClient.RepositoriesApi
 	pipeApi := c.ApiClient.PipelinesApi
 	client := m.(Clients).httpClient
 
@@ -320,15 +477,16 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m inter
 	}
 	repoSlug = computeSlug(repoSlug)
 
-	repoRes, res, err := repoApi.RepositoriesWorkspaceRepoSlugGet(c.AuthContext, repoSlug, workspace)
+	var repoRes RepositoryWithWebsite
+	err = client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/repositories/%s/%s", workspace, repoSlug), nil, &repoRes)
 
-	if res.StatusCode == http.StatusNotFound {
+	if IsNotFound(err) {
 		log.Printf("[WARN] Repository (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	if err := handleClientError(err); err != nil {
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -341,8 +499,11 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m inter
 	d.Set("slug", repoRes.Slug)
 	d.Set("language", repoRes.Language)
 	d.Set("fork_policy", repoRes.ForkPolicy)
-	// d.Set("website", repoRes.Website)
+	d.Set("website", repoRes.Website)
 	d.Set("description", repoRes.Description)
+	if repoRes.Mainbranch != nil {
+		d.Set("main_branch", repoRes.Mainbranch.Name)
+	}
 	d.Set("project_key", repoRes.Project.Key)
 	d.Set("uuid", repoRes.Uuid)
 
@@ -399,22 +560,19 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceRepositoryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-
-	var repoSlug string
-	repoSlug = d.Get("slug").(string)
-	if repoSlug == "" {
-		repoSlug = d.Get("name").(string)
-	}
-
-	c := m.(Clients).genClient
-	repoApi := c.ApiClient.RepositoriesApi
-
-	_, err := repoApi.RepositoriesWorkspaceRepoSlugDelete(c.AuthContext, repoSlug, d.Get("owner").(string), nil)
-	if err := handleClientError(err); err != nil {
+	// Like for updates the ID is what the repository was last seen as, the
+	// configured slug can differ from it.
+	workspace, repoSlug, err := repositoryId(d.Id())
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return nil
+	// Sent through the HTTP client so delete_concurrency bounds destroying
+	// many repositories at once.
+	client := m.(Clients).httpClient
+	_, err = client.Delete(fmt.Sprintf("2.0/repositories/%s/%s", workspace, repoSlug))
+
+	return diag.FromErr(err)
 }
 
 var slugForbiddenCharacters *regexp.Regexp = regexp.MustCompile(`[\W-]`)
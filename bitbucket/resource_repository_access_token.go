@@ -0,0 +1,227 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// AccessToken is a scoped credential issued for a repository, project or
+// workspace. The token value is only returned when it is created.
+type AccessToken struct {
+	ID     string   `json:"id,omitempty"`
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+	Token  string   `json:"token,omitempty"`
+}
+
+var repositoryAccessTokenScopes = []string{
+	"repository",
+	"repository:write",
+	"repository:admin",
+	"repository:delete",
+	"pullrequest",
+	"pullrequest:write",
+	"webhook",
+	"pipeline",
+	"pipeline:write",
+	"pipeline:variable",
+	"runner",
+	"runner:write",
+}
+
+func resourceRepositoryAccessToken() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: repositoryAccessTokenOwner.create,
+		ReadWithoutTimeout:   repositoryAccessTokenOwner.read,
+		UpdateWithoutTimeout: repositoryAccessTokenOwner.update,
+		DeleteWithoutTimeout: repositoryAccessTokenOwner.delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"scopes": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(repositoryAccessTokenScopes, false),
+				},
+			},
+			"token_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func expandAccessToken(d *schema.ResourceData) *AccessToken {
+	token := &AccessToken{
+		Name: d.Get("name").(string),
+	}
+
+	for _, scope := range d.Get("scopes").(*schema.Set).List() {
+		token.Scopes = append(token.Scopes, scope.(string))
+	}
+
+	return token
+}
+
+// accessTokenOwner is what an access token is issued for. keys are the
+// attributes the resource ID is made of ahead of the token ID, endpoint builds
+// the access tokens collection from their values.
+type accessTokenOwner struct {
+	kind     string
+	keys     []string
+	idFormat string
+	endpoint func(keys []string) string
+}
+
+var repositoryAccessTokenOwner = accessTokenOwner{
+	kind:     "Repository Access Token",
+	keys:     []string{"workspace", "repository"},
+	idFormat: "WORKSPACE/REPO-SLUG/TOKEN-ID",
+	endpoint: func(keys []string) string {
+		return fmt.Sprintf("2.0/repositories/%s/%s/access-tokens", keys[0], keys[1])
+	},
+}
+
+func (o accessTokenOwner) create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	var keys []string
+	for _, key := range o.keys {
+		keys = append(keys, d.Get(key).(string))
+	}
+
+	bytedata, err := json.Marshal(expandAccessToken(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var token AccessToken
+	err = client.DoAndDecode(http.MethodPost, o.endpoint(keys), bytes.NewBuffer(bytedata), &token)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.Join(append(keys, token.ID), "/"))
+	// Bitbucket never returns the token again, read leaves it alone.
+	d.Set("token", token.Token)
+
+	return o.read(ctx, d, m)
+}
+
+func (o accessTokenOwner) read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	keys, tokenID, err := o.parseId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var token AccessToken
+	err = client.DoAndDecode(http.MethodGet, fmt.Sprintf("%s/%s", o.endpoint(keys), tokenID), nil, &token)
+
+	if IsNotFound(err) {
+		log.Printf("[WARN] %s (%s) not found, removing from state", o.kind, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for i, key := range o.keys {
+		d.Set(key, keys[i])
+	}
+	d.Set("token_id", tokenID)
+	d.Set("name", token.Name)
+	d.Set("scopes", token.Scopes)
+
+	return nil
+}
+
+func (o accessTokenOwner) update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	keys, tokenID, err := o.parseId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bytedata, err := json.Marshal(expandAccessToken(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Put(fmt.Sprintf("%s/%s", o.endpoint(keys), tokenID), bytes.NewBuffer(bytedata))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return o.read(ctx, d, m)
+}
+
+func (o accessTokenOwner) delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	keys, tokenID, err := o.parseId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Delete(fmt.Sprintf("%s/%s", o.endpoint(keys), tokenID))
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func (o accessTokenOwner) parseId(id string) ([]string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != len(o.keys)+1 {
+		return nil, "", fmt.Errorf("unexpected format of ID (%q), expected %s", id, o.idFormat)
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			return nil, "", fmt.Errorf("unexpected format of ID (%q), expected %s", id, o.idFormat)
+		}
+	}
+
+	return parts[:len(o.keys)], parts[len(o.keys)], nil
+}
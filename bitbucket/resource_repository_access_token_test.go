@@ -0,0 +1,168 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBitbucketRepositoryAccessToken_basic(t *testing.T) {
+	resourceName := "bitbucket_repository_access_token.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketRepositoryAccessTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryAccessTokenConfig(workspace, rName, rName, "repository"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketRepositoryAccessTokenExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "workspace", workspace),
+					resource.TestCheckResourceAttrPair(resourceName, "repository", "bitbucket_repository.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "repository"),
+					resource.TestCheckResourceAttrSet(resourceName, "token_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+				),
+			},
+			{
+				Config: testAccBitbucketRepositoryAccessTokenConfig(workspace, rName, rName+"-updated", "pullrequest"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketRepositoryAccessTokenExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "pullrequest"),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func TestAccessTokenOwnerCreate(t *testing.T) {
+	var requests []string
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method {
+		case http.MethodPost:
+			w.Write([]byte(`{"id":"42","name":"ci","scopes":["repository"],"token":"secret"}`))
+		case http.MethodGet:
+			w.Write([]byte(`{"id":"42","name":"ci","scopes":["repository"]}`))
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceRepositoryAccessToken().Schema, map[string]interface{}{
+		"workspace":  "ws",
+		"repository": "repo",
+		"name":       "ci",
+		"scopes":     []interface{}{"repository"},
+	})
+
+	if diags := repositoryAccessTokenOwner.create(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []string{
+		"POST /2.0/repositories/ws/repo/access-tokens",
+		"GET /2.0/repositories/ws/repo/access-tokens/42",
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+
+	if d.Id() != "ws/repo/42" {
+		t.Errorf("expected ID ws/repo/42, got %q", d.Id())
+	}
+
+	if got := d.Get("token").(string); got != "secret" {
+		t.Errorf("expected token to be kept from create, got %q", got)
+	}
+}
+
+func TestAccessTokenOwnerParseId(t *testing.T) {
+	for id, valid := range map[string]bool{
+		"ws/repo/42": true,
+		"ws/repo":    false,
+		"ws//42":     false,
+		"ws/repo/":   false,
+		"ws/a/b/42":  false,
+	} {
+		keys, tokenID, err := repositoryAccessTokenOwner.parseId(id)
+		if valid != (err == nil) {
+			t.Errorf("%q: expected valid=%t, got error %v", id, valid, err)
+			continue
+		}
+
+		if valid && (fmt.Sprint(keys) != "[ws repo]" || tokenID != "42") {
+			t.Errorf("%q: unexpected keys %v and token ID %q", id, keys, tokenID)
+		}
+	}
+}
+
+func testAccCheckBitbucketRepositoryAccessTokenDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(Clients).httpClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bitbucket_repository_access_token" {
+			continue
+		}
+
+		_, err := client.Get(fmt.Sprintf("2.0/repositories/%s/%s/access-tokens/%s", rs.Primary.Attributes["workspace"], rs.Primary.Attributes["repository"], rs.Primary.Attributes["token_id"]))
+
+		if err == nil {
+			return fmt.Errorf("The resource was found should have errored")
+		}
+
+		if !IsNotFound(err) {
+			return fmt.Errorf("Repository Access Token still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckBitbucketRepositoryAccessTokenExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Repository Access Token ID is set")
+		}
+		return nil
+	}
+}
+
+func testAccBitbucketRepositoryAccessTokenConfig(workspace, rName, tokenName, scope string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_repository_access_token" "test" {
+  workspace  = %[1]q
+  repository = bitbucket_repository.test.name
+  name       = %[3]q
+  scopes     = [%[4]q]
+}
+`, workspace, rName, tokenName, scope)
+}
@@ -0,0 +1,221 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// RepositoryFork is a repository forked from another one
+type RepositoryFork struct {
+	Name      string          `json:"name,omitempty"`
+	Slug      string          `json:"slug,omitempty"`
+	FullName  string          `json:"full_name,omitempty"`
+	UUID      string          `json:"uuid,omitempty"`
+	IsPrivate bool            `json:"is_private"`
+	Workspace *forkWorkspace  `json:"workspace,omitempty"`
+	Project   *forkProject    `json:"project,omitempty"`
+	Parent    *RepositoryFork `json:"parent,omitempty"`
+}
+
+type forkProject struct {
+	Key string `json:"key,omitempty"`
+}
+
+func resourceRepositoryFork() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRepositoryForkCreate,
+		ReadWithoutTimeout:   resourceRepositoryForkRead,
+		UpdateWithoutTimeout: resourceRepositoryForkUpdate,
+		DeleteWithoutTimeout: resourceRepositoryForkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"project_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"is_private": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"slug": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"full_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandRepositoryFork(d *schema.ResourceData) *RepositoryFork {
+	fork := &RepositoryFork{
+		Name:      d.Get("name").(string),
+		IsPrivate: d.Get("is_private").(bool),
+		Workspace: &forkWorkspace{Slug: d.Get("workspace").(string)},
+	}
+
+	if v, ok := d.GetOk("project_key"); ok {
+		fork.Project = &forkProject{Key: v.(string)}
+	}
+
+	return fork
+}
+
+func resourceRepositoryForkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	bytedata, err := json.Marshal(expandRepositoryFork(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var fork RepositoryFork
+	err = client.DoAndDecode(http.MethodPost, fmt.Sprintf("2.0/repositories/%s/%s/forks",
+		d.Get("source_workspace").(string), d.Get("source_repository").(string)), bytes.NewBuffer(bytedata), &fork)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	workspace := d.Get("workspace").(string)
+	d.SetId(fmt.Sprintf("%s/%s", workspace, fork.Slug))
+
+	if err := waitForRepository(ctx, client, workspace, fork.Slug, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRepositoryForkRead(ctx, d, m)
+}
+
+func resourceRepositoryForkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repoSlug, err := repositoryForkId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var fork RepositoryFork
+	err = client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/repositories/%s/%s", workspace, repoSlug), nil, &fork)
+
+	if IsNotFound(err) {
+		log.Printf("[WARN] Repository Fork (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("workspace", workspace)
+	d.Set("slug", fork.Slug)
+	d.Set("name", fork.Name)
+	d.Set("full_name", fork.FullName)
+	d.Set("uuid", fork.UUID)
+	d.Set("is_private", fork.IsPrivate)
+
+	if fork.Project != nil {
+		d.Set("project_key", fork.Project.Key)
+	}
+
+	if fork.Parent != nil {
+		if parts := strings.SplitN(fork.Parent.FullName, "/", 2); len(parts) == 2 {
+			d.Set("source_workspace", parts[0])
+			d.Set("source_repository", parts[1])
+		}
+	}
+
+	return nil
+}
+
+func resourceRepositoryForkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repoSlug, err := repositoryForkId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bytedata, err := json.Marshal(expandRepositoryFork(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Put(fmt.Sprintf("2.0/repositories/%s/%s", workspace, repoSlug), bytes.NewBuffer(bytedata))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRepositoryForkRead(ctx, d, m)
+}
+
+func resourceRepositoryForkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repoSlug, err := repositoryForkId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Delete(fmt.Sprintf("2.0/repositories/%s/%s", workspace, repoSlug))
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func repositoryForkId(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/REPO-SLUG", id)
+	}
+
+	return parts[0], parts[1], nil
+}
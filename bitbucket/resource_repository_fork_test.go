@@ -0,0 +1,159 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBitbucketRepositoryFork_basic(t *testing.T) {
+	resourceName := "bitbucket_repository_fork.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketRepositoryForkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryForkConfig(workspace, rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketRepositoryForkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "workspace", workspace),
+					resource.TestCheckResourceAttr(resourceName, "source_workspace", workspace),
+					resource.TestCheckResourceAttrPair(resourceName, "source_repository", "bitbucket_repository.test", "slug"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-fork"),
+					resource.TestCheckResourceAttr(resourceName, "is_private", "true"),
+					resource.TestCheckResourceAttr(resourceName, "full_name", fmt.Sprintf("%s/%s-fork", workspace, rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "uuid"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryForkCreate(t *testing.T) {
+	defer func(interval time.Duration) { repositoryPollInterval = interval }(repositoryPollInterval)
+	repositoryPollInterval = 10 * time.Millisecond
+
+	var payload string
+	var polls int
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/2.0/repositories/upstream/repo/forks":
+			body, _ := io.ReadAll(r.Body)
+			payload = string(body)
+			w.Write([]byte(`{"slug":"fork","full_name":"example/fork"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/2.0/repositories/example/fork":
+			// forking is asynchronous, the new repository shows up eventually
+			polls++
+			if polls < 3 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(`{"name":"fork","slug":"fork","full_name":"example/fork","uuid":"{fork}","is_private":true,"project":{"key":"PROJ"},"parent":{"full_name":"upstream/repo"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceRepositoryFork().Schema, map[string]interface{}{
+		"source_workspace":  "upstream",
+		"source_repository": "repo",
+		"workspace":         "example",
+		"name":              "fork",
+		"project_key":       "PROJ",
+	})
+
+	if diags := resourceRepositoryForkCreate(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expectedPayload := `{"name":"fork","is_private":true,"workspace":{"slug":"example"},"project":{"key":"PROJ"}}`
+	if payload != expectedPayload {
+		t.Errorf("expected payload %s, got %s", expectedPayload, payload)
+	}
+
+	if d.Id() != "example/fork" {
+		t.Errorf("expected ID example/fork, got %q", d.Id())
+	}
+
+	expected := map[string]string{
+		"full_name":         "example/fork",
+		"uuid":              "{fork}",
+		"project_key":       "PROJ",
+		"source_workspace":  "upstream",
+		"source_repository": "repo",
+	}
+	for k, v := range expected {
+		if got := d.Get(k).(string); got != v {
+			t.Errorf("expected %s to be %q, got %q", k, v, got)
+		}
+	}
+}
+
+func testAccCheckBitbucketRepositoryForkDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(Clients).httpClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bitbucket_repository_fork" {
+			continue
+		}
+
+		_, err := client.Get(fmt.Sprintf("2.0/repositories/%s", rs.Primary.ID))
+
+		if err == nil {
+			return fmt.Errorf("The resource was found should have errored")
+		}
+
+		if !IsNotFound(err) {
+			return fmt.Errorf("Repository Fork still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckBitbucketRepositoryForkExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Repository Fork ID is set")
+		}
+		return nil
+	}
+}
+
+func testAccBitbucketRepositoryForkConfig(workspace, rName string, isPrivate bool) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_repository_fork" "test" {
+  source_workspace  = %[1]q
+  source_repository = bitbucket_repository.test.slug
+  workspace         = %[1]q
+  name              = "%[2]s-fork"
+  is_private        = %[3]t
+}
+`, workspace, rName, isPrivate)
+}
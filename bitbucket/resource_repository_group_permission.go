@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"net/http"
 	"strings"
 
 	"github.com/DrFaust92/bitbucket-go-client"
@@ -125,7 +124,7 @@ func resourceRepositoryGroupPermissionRead(ctx context.Context, d *schema.Resour
 		groupSlug,
 	))
 
-	if permissionReq.StatusCode == http.StatusNotFound {
+	if IsNotFound(err) {
 		log.Printf("[WARN] Repository Group Permission (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -152,8 +151,8 @@ func resourceRepositoryGroupPermissionRead(ctx context.Context, d *schema.Resour
 	log.Printf("Repository Group Permission decoded is: %#v", permission)
 
 	d.Set("permission", permission.Permission)
-	d.Set("group_slug", permission.Group.Slug)
-	d.Set("workspace", permission.Group.Workspace.Slug)
+	d.Set("group_slug", groupSlug)
+	d.Set("workspace", workspace)
 	d.Set("repo_slug", repoSlug)
 
 	return nil
@@ -176,12 +175,14 @@ func resourceRepositoryGroupPermissionDelete(ctx context.Context, d *schema.Reso
 	return diag.FromErr(err)
 }
 
+// repositoryGroupPermissionId also accepts WORKSPACE/REPO-SLUG/GROUP-SLUG for imports.
 func repositoryGroupPermissionId(id string) (string, string, string, error) {
-	parts := strings.Split(id, ":")
-
-	if len(parts) != 3 {
-		return "", "", "", fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE:REPO-SLUG:GROUP-SLUG", id)
+	for _, sep := range []string{":", "/"} {
+		parts := strings.Split(id, sep)
+		if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+			return parts[0], parts[1], parts[2], nil
+		}
 	}
 
-	return parts[0], parts[1], parts[2], nil
+	return "", "", "", fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE:REPO-SLUG:GROUP-SLUG", id)
 }
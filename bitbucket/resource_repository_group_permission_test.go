@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -51,6 +53,54 @@ func TestAccBitbucketRepositoryGroupPermission_basic(t *testing.T) {
 	})
 }
 
+func TestRepositoryGroupPermissionId(t *testing.T) {
+	for _, id := range []string{"example:repo:developers", "example/repo/developers"} {
+		workspace, repoSlug, groupSlug, err := repositoryGroupPermissionId(id)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %s", id, err)
+		}
+
+		if workspace != "example" || repoSlug != "repo" || groupSlug != "developers" {
+			t.Errorf("%q: unexpected parts %q, %q and %q", id, workspace, repoSlug, groupSlug)
+		}
+	}
+
+	for _, id := range []string{"example:repo", "example/repo/", "example:repo/developers", "a:b:c:d"} {
+		if _, _, _, err := repositoryGroupPermissionId(id); err == nil {
+			t.Errorf("%q: expected an error", id)
+		}
+	}
+}
+
+func TestResourceRepositoryGroupPermissionRead_import(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2.0/repositories/example/repo/permissions-config/groups/developers" {
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+		// the group of the answer doesn't carry the workspace
+		w.Write([]byte(`{"permission":"write","group":{"slug":"developers"}}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceRepositoryGroupPermission().Schema, map[string]interface{}{})
+	d.SetId("example/repo/developers")
+
+	if diags := resourceRepositoryGroupPermissionRead(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := map[string]string{
+		"workspace":  "example",
+		"repo_slug":  "repo",
+		"group_slug": "developers",
+		"permission": "write",
+	}
+	for k, v := range expected {
+		if got := d.Get(k).(string); got != v {
+			t.Errorf("expected %s to be %q, got %q", k, v, got)
+		}
+	}
+}
+
 func testAccCheckBitbucketRepositoryGroupPermissionDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(Clients).httpClient
 	for _, rs := range s.RootModule().Resources {
@@ -0,0 +1,245 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceRepositoryGroupPermissions() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRepositoryGroupPermissionsCreate,
+		ReadWithoutTimeout:   resourceRepositoryGroupPermissionsRead,
+		UpdateWithoutTimeout: resourceRepositoryGroupPermissionsUpdate,
+		DeleteWithoutTimeout: resourceRepositoryGroupPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRepositoryGroupPermissionsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"groups": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_slug": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"permission": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"admin", "write", "read"}, false),
+						},
+					},
+				},
+			},
+			"manage_exclusively": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+// expandRepositoryGroupPermissions maps the group slugs of a groups set to
+// their permission.
+func expandRepositoryGroupPermissions(groups *schema.Set) map[string]string {
+	permissions := make(map[string]string, groups.Len())
+
+	for _, item := range groups.List() {
+		group := item.(map[string]interface{})
+		permissions[group["group_slug"].(string)] = group["permission"].(string)
+	}
+
+	return permissions
+}
+
+func repositoryGroupPermissionEndpoint(workspace, repoSlug, groupSlug string) string {
+	return fmt.Sprintf("2.0/repositories/%s/%s/permissions-config/groups/%s", workspace, repoSlug, groupSlug)
+}
+
+func putRepositoryGroupPermission(client *Client, workspace, repoSlug, groupSlug, permission string) error {
+	payload, err := json.Marshal(&RepositoryGroupPermission{Permission: permission})
+	if err != nil {
+		return err
+	}
+
+	res, err := client.Put(repositoryGroupPermissionEndpoint(workspace, repoSlug, groupSlug), bytes.NewBuffer(payload))
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+
+	return nil
+}
+
+func deleteRepositoryGroupPermission(client *Client, workspace, repoSlug, groupSlug string) error {
+	_, err := client.Delete(repositoryGroupPermissionEndpoint(workspace, repoSlug, groupSlug))
+	if err != nil && !IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func resourceRepositoryGroupPermissionsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repoSlug := d.Get("repository").(string)
+
+	for groupSlug, permission := range expandRepositoryGroupPermissions(d.Get("groups").(*schema.Set)) {
+		if err := putRepositoryGroupPermission(client, workspace, repoSlug, groupSlug, permission); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, repoSlug))
+
+	return resourceRepositoryGroupPermissionsRead(ctx, d, m)
+}
+
+func resourceRepositoryGroupPermissionsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	workspace, repoSlug, err := repositoryGroupPermissionsId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("workspace", workspace)
+	d.Set("repository", repoSlug)
+	d.Set("manage_exclusively", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceRepositoryGroupPermissionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repoSlug, err := repositoryGroupPermissionsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Grants made outside of Terraform are ignored unless all of them are
+	// managed, otherwise they show up as drift and get removed.
+	managed := expandRepositoryGroupPermissions(d.Get("groups").(*schema.Set))
+	exclusive := d.Get("manage_exclusively").(bool)
+
+	var groups []interface{}
+	err = client.GetPaged(fmt.Sprintf("2.0/repositories/%s/%s/permissions-config/groups", workspace, repoSlug), func(value json.RawMessage) error {
+		var permission RepositoryGroupPermission
+		if err := json.Unmarshal(value, &permission); err != nil {
+			return err
+		}
+
+		if permission.Group == nil {
+			return nil
+		}
+
+		if _, ok := managed[permission.Group.Slug]; exclusive || ok {
+			groups = append(groups, map[string]interface{}{
+				"group_slug": permission.Group.Slug,
+				"permission": permission.Permission,
+			})
+		}
+
+		return nil
+	})
+
+	if IsNotFound(err) {
+		log.Printf("[WARN] Repository Group Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("workspace", workspace)
+	d.Set("repository", repoSlug)
+	d.Set("groups", groups)
+
+	return nil
+}
+
+func resourceRepositoryGroupPermissionsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repoSlug, err := repositoryGroupPermissionsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oraw, nraw := d.GetChange("groups")
+	o := expandRepositoryGroupPermissions(oraw.(*schema.Set))
+	n := expandRepositoryGroupPermissions(nraw.(*schema.Set))
+
+	for groupSlug, permission := range n {
+		if o[groupSlug] == permission {
+			continue
+		}
+
+		if err := putRepositoryGroupPermission(client, workspace, repoSlug, groupSlug, permission); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for groupSlug := range o {
+		if _, ok := n[groupSlug]; ok {
+			continue
+		}
+
+		if err := deleteRepositoryGroupPermission(client, workspace, repoSlug, groupSlug); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceRepositoryGroupPermissionsRead(ctx, d, m)
+}
+
+func resourceRepositoryGroupPermissionsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repoSlug, err := repositoryGroupPermissionsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var endpoints []string
+	for groupSlug := range expandRepositoryGroupPermissions(d.Get("groups").(*schema.Set)) {
+		endpoints = append(endpoints, repositoryGroupPermissionEndpoint(workspace, repoSlug, groupSlug))
+	}
+
+	return diag.FromErr(client.DeleteAll(endpoints))
+}
+
+func repositoryGroupPermissionsId(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/REPO-SLUG", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,240 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBitbucketRepositoryGroupPermissions_basic(t *testing.T) {
+	resourceName := "bitbucket_repository_group_permissions.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketRepositoryGroupPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryGroupPermissionsConfig(workspace, rName, "read", "write"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "repository", "bitbucket_repository.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "workspace", workspace),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "groups.*", map[string]string{
+						"group_slug": rName + "-one",
+						"permission": "read",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "groups.*", map[string]string{
+						"group_slug": rName + "-two",
+						"permission": "write",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketRepositoryGroupPermissionsConfig(workspace, rName, "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "groups.*", map[string]string{
+						"group_slug": rName + "-one",
+						"permission": "admin",
+					}),
+				),
+			},
+			{
+				// A grant made outside of Terraform is drift and gets removed.
+				PreConfig: func() {
+					client := testAccProvider.Meta().(Clients).httpClient
+					if err := putRepositoryGroupPermission(client, workspace, rName, rName+"-two", "read"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccBitbucketRepositoryGroupPermissionsConfig(workspace, rName, "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+					testAccCheckBitbucketRepositoryGroupPermissionRemoved(workspace, rName, rName+"-two"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryGroupPermissionsRead_manageExclusively(t *testing.T) {
+	for exclusive, expected := range map[bool][]string{
+		true:  {"developers:write", "manual:read"},
+		false: {"developers:write"},
+	} {
+		client := testClient(t, testPagedHandler(t,
+			`[{"permission":"read","group":{"slug":"manual"}}]`,
+			`[{"permission":"write","group":{"slug":"developers"}}]`,
+		))
+
+		d := schema.TestResourceDataRaw(t, resourceRepositoryGroupPermissions().Schema, map[string]interface{}{
+			"workspace":  "example",
+			"repository": "repo",
+			"groups": []interface{}{
+				map[string]interface{}{"group_slug": "developers", "permission": "read"},
+			},
+			"manage_exclusively": exclusive,
+		})
+		d.SetId("example/repo")
+
+		if diags := resourceRepositoryGroupPermissionsRead(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		var groups []string
+		for groupSlug, permission := range expandRepositoryGroupPermissions(d.Get("groups").(*schema.Set)) {
+			groups = append(groups, groupSlug+":"+permission)
+		}
+		sort.Strings(groups)
+
+		if fmt.Sprint(groups) != fmt.Sprint(expected) {
+			t.Errorf("expected groups %v with manage_exclusively = %t, got %v", expected, exclusive, groups)
+		}
+	}
+}
+
+func TestResourceRepositoryGroupPermissionsUpdate(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"values":[]}`)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+		mu.Unlock()
+
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	resourceSchema := resourceRepositoryGroupPermissions().Schema
+	current := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"workspace":  "example",
+		"repository": "repo",
+		"groups": []interface{}{
+			map[string]interface{}{"group_slug": "same", "permission": "read"},
+			map[string]interface{}{"group_slug": "changed", "permission": "read"},
+			map[string]interface{}{"group_slug": "removed", "permission": "admin"},
+		},
+	})
+	current.SetId("example/repo")
+	state := current.State()
+
+	diff, err := schema.InternalMap(resourceSchema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"workspace":  "example",
+		"repository": "repo",
+		"groups": []interface{}{
+			map[string]interface{}{"group_slug": "same", "permission": "read"},
+			map[string]interface{}{"group_slug": "changed", "permission": "write"},
+			map[string]interface{}{"group_slug": "added", "permission": "read"},
+		},
+	}), nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := schema.InternalMap(resourceSchema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := resourceRepositoryGroupPermissionsUpdate(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	sort.Strings(requests)
+	expected := []string{
+		`DELETE /2.0/repositories/example/repo/permissions-config/groups/removed `,
+		`PUT /2.0/repositories/example/repo/permissions-config/groups/added {"permission":"read"}`,
+		`PUT /2.0/repositories/example/repo/permissions-config/groups/changed {"permission":"write"}`,
+	}
+
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Errorf("expected requests\n%v\ngot\n%v", expected, requests)
+	}
+}
+
+func testAccCheckBitbucketRepositoryGroupPermissionsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(Clients).httpClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bitbucket_repository_group_permissions" {
+			continue
+		}
+
+		_, err := client.Get(fmt.Sprintf("2.0/repositories/%s/%s/permissions-config/groups/%s-one", rs.Primary.Attributes["workspace"], rs.Primary.Attributes["repository"], rs.Primary.Attributes["repository"]))
+		if !IsNotFound(err) {
+			return fmt.Errorf("Repository Group Permissions still exist")
+		}
+	}
+	return nil
+}
+
+func testAccCheckBitbucketRepositoryGroupPermissionRemoved(workspace, repoSlug, groupSlug string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(Clients).httpClient
+
+		_, err := client.Get(fmt.Sprintf("2.0/repositories/%s/%s/permissions-config/groups/%s", workspace, repoSlug, groupSlug))
+		if !IsNotFound(err) {
+			return fmt.Errorf("expected the permission of group %s to be removed, got %v", groupSlug, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccBitbucketRepositoryGroupPermissionsConfig(workspace, rName string, permissions ...string) string {
+	groups := ""
+	for i, permission := range permissions {
+		groups += fmt.Sprintf(`
+  groups {
+    group_slug = bitbucket_group.test[%d].slug
+    permission = %q
+  }
+`, i, permission)
+	}
+
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_group" "test" {
+  count = 2
+
+  workspace = %[1]q
+  name      = "%[2]s-${["one", "two"][count.index]}"
+}
+
+resource "bitbucket_repository_group_permissions" "test" {
+  workspace  = %[1]q
+  repository = bitbucket_repository.test.name
+%[3]s}
+`, workspace, rName, groups)
+}
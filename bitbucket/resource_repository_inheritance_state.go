@@ -0,0 +1,172 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// PipelineBuildNumber is the number the next pipeline of a repository gets
+type PipelineBuildNumber struct {
+	Next int `json:"next"`
+}
+
+func resourceRepositoryInheritanceState() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRepositoryInheritanceStatePut,
+		ReadWithoutTimeout:   resourceRepositoryInheritanceStateRead,
+		UpdateWithoutTimeout: resourceRepositoryInheritanceStatePut,
+		DeleteWithoutTimeout: resourceRepositoryInheritanceStateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"build_number": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"next_build_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"inherit_default_merge_strategy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"inherit_branching_model": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRepositoryInheritanceStatePut(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+
+	// Read keeps the configured build number once pipelines moved past it,
+	// sending it again would reset the counter and reuse build numbers.
+	if d.IsNewResource() || d.HasChange("build_number") {
+		payload, err := json.Marshal(&PipelineBuildNumber{Next: d.Get("build_number").(int)})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		res, err := client.Put(fmt.Sprintf("2.0/repositories/%s/%s/pipelines_config/build_number", workspace, repo), bytes.NewBuffer(payload))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		res.Body.Close()
+	}
+
+	// nolint:staticcheck
+	_, branchOk := d.GetOkExists("inherit_branching_model")
+	// nolint:staticcheck
+	_, mergeStratOk := d.GetOkExists("inherit_default_merge_strategy")
+
+	if (mergeStratOk || branchOk) && (d.IsNewResource() || d.HasChanges("inherit_default_merge_strategy", "inherit_branching_model")) {
+		payload, err := json.Marshal(createRepositoryInheritanceSettings(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		res, err := client.Put(fmt.Sprintf("2.0/repositories/%s/%s/override-settings", workspace, repo), bytes.NewBuffer(payload))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		res.Body.Close()
+	}
+
+	if d.IsNewResource() {
+		d.SetId(fmt.Sprintf("%s/%s", workspace, repo))
+	}
+
+	return resourceRepositoryInheritanceStateRead(ctx, d, m)
+}
+
+func resourceRepositoryInheritanceStateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repo, err := repositoryInheritanceStateId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var buildNumber PipelineBuildNumber
+	err = client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/repositories/%s/%s/pipelines_config/build_number", workspace, repo), nil, &buildNumber)
+
+	if IsNotFound(err) {
+		log.Printf("[WARN] Repository Inheritance State (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("workspace", workspace)
+	d.Set("repository", repo)
+	d.Set("next_build_number", buildNumber.Next)
+
+	var setting RepositoryInheritanceSettings
+	err = client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/repositories/%s/%s/override-settings", workspace, repo), nil, &setting)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("inherit_default_merge_strategy", setting.DefaultMergeStrategy)
+	d.Set("inherit_branching_model", setting.BranchingModel)
+
+	// Every pipeline run increments the build number, that's expected. Only
+	// a build number below the configured one, e.g. after it was reset, is
+	// drift.
+	if configured := d.Get("build_number").(int); buildNumber.Next < configured || configured == 0 {
+		d.Set("build_number", buildNumber.Next)
+	}
+
+	return nil
+}
+
+func resourceRepositoryInheritanceStateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The build number can't be unset, the pipelines simply keep counting, and
+	// the inheritance is left as it is.
+	log.Printf("[DEBUG] Removing Repository Inheritance State (%s) from state only", d.Id())
+
+	return nil
+}
+
+func repositoryInheritanceStateId(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/REPO-SLUG", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,216 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBitbucketRepositoryInheritanceState_basic(t *testing.T) {
+	resourceName := "bitbucket_repository_inheritance_state.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryInheritanceStateConfig(workspace, rName, 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "workspace", workspace),
+					resource.TestCheckResourceAttr(resourceName, "repository", rName),
+					resource.TestCheckResourceAttr(resourceName, "build_number", "100"),
+					resource.TestCheckResourceAttr(resourceName, "next_build_number", "100"),
+					resource.TestCheckResourceAttr(resourceName, "inherit_branching_model", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketRepositoryInheritanceStateConfig(workspace, rName, 250),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "build_number", "250"),
+					resource.TestCheckResourceAttr(resourceName, "next_build_number", "250"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryInheritanceStateRead(t *testing.T) {
+	cases := []struct {
+		configured, next, expected int
+	}{
+		// pipelines ran since the build number was set
+		{100, 142, 100},
+		// the build number was reset outside of Terraform
+		{100, 7, 7},
+		// imported
+		{0, 42, 42},
+	}
+
+	for _, tc := range cases {
+		client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/2.0/repositories/example/repo/pipelines_config/build_number":
+				fmt.Fprintf(w, `{"next":%d}`, tc.next)
+			case "/2.0/repositories/example/repo/override-settings":
+				fmt.Fprint(w, `{"default_merge_strategy":true,"branching_model":true}`)
+			default:
+				t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			}
+		})
+
+		raw := map[string]interface{}{
+			"workspace":  "example",
+			"repository": "repo",
+		}
+		if tc.configured != 0 {
+			raw["build_number"] = tc.configured
+		}
+
+		d := schema.TestResourceDataRaw(t, resourceRepositoryInheritanceState().Schema, raw)
+		d.SetId("example/repo")
+
+		if diags := resourceRepositoryInheritanceStateRead(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if got := d.Get("build_number").(int); got != tc.expected {
+			t.Errorf("expected build_number %d for %d configured and %d next, got %d", tc.expected, tc.configured, tc.next, got)
+		}
+
+		if got := d.Get("next_build_number").(int); got != tc.next {
+			t.Errorf("expected next_build_number %d, got %d", tc.next, got)
+		}
+	}
+}
+
+func TestResourceRepositoryInheritanceStatePut(t *testing.T) {
+	var payloads = map[string]string{}
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			payloads[r.URL.Path] = string(body)
+		}
+
+		switch r.URL.Path {
+		case "/2.0/repositories/example/repo/pipelines_config/build_number":
+			fmt.Fprint(w, `{"next":100}`)
+		case "/2.0/repositories/example/repo/override-settings":
+			fmt.Fprint(w, `{"default_merge_strategy":false,"branching_model":true}`)
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceRepositoryInheritanceState().Schema, map[string]interface{}{
+		"workspace":                      "example",
+		"repository":                     "repo",
+		"build_number":                   100,
+		"inherit_default_merge_strategy": false,
+	})
+	d.MarkNewResource()
+
+	if diags := resourceRepositoryInheritanceStatePut(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := map[string]string{
+		"/2.0/repositories/example/repo/pipelines_config/build_number": `{"next":100}`,
+		"/2.0/repositories/example/repo/override-settings":             `{"default_merge_strategy":false}`,
+	}
+	for path, payload := range expected {
+		if payloads[path] != payload {
+			t.Errorf("expected %s to be sent %s, got %s", path, payload, payloads[path])
+		}
+	}
+
+	if d.Get("inherit_default_merge_strategy").(bool) || !d.Get("inherit_branching_model").(bool) {
+		t.Errorf("expected the inheritance to be read back, got merge strategy %t and branching model %t",
+			d.Get("inherit_default_merge_strategy").(bool), d.Get("inherit_branching_model").(bool))
+	}
+}
+
+func TestResourceRepositoryInheritanceStatePut_toggleOnly(t *testing.T) {
+	var puts []string
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			puts = append(puts, r.URL.Path)
+		}
+
+		switch r.URL.Path {
+		case "/2.0/repositories/example/repo/pipelines_config/build_number":
+			fmt.Fprint(w, `{"next":142}`)
+		case "/2.0/repositories/example/repo/override-settings":
+			fmt.Fprint(w, `{"default_merge_strategy":true,"branching_model":false}`)
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	resourceSchema := resourceRepositoryInheritanceState().Schema
+	raw := map[string]interface{}{
+		"workspace":               "example",
+		"repository":              "repo",
+		"build_number":            100,
+		"inherit_branching_model": true,
+	}
+
+	current := schema.TestResourceDataRaw(t, resourceSchema, raw)
+	current.SetId("example/repo")
+	state := current.State()
+
+	raw["inherit_branching_model"] = false
+	diff, err := schema.InternalMap(resourceSchema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := schema.InternalMap(resourceSchema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := resourceRepositoryInheritanceStatePut(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// pipelines ran up to 142, the configured 100 must not be sent again
+	expected := []string{"/2.0/repositories/example/repo/override-settings"}
+	if fmt.Sprint(puts) != fmt.Sprint(expected) {
+		t.Errorf("expected only %v to be updated, got %v", expected, puts)
+	}
+}
+
+func testAccBitbucketRepositoryInheritanceStateConfig(workspace, rName string, buildNumber int) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner             = %[1]q
+  name              = %[2]q
+  pipelines_enabled = true
+}
+
+resource "bitbucket_repository_inheritance_state" "test" {
+  workspace    = %[1]q
+  repository   = bitbucket_repository.test.name
+  build_number = %[3]d
+
+  inherit_branching_model = false
+}
+`, workspace, rName, buildNumber)
+}
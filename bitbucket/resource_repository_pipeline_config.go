@@ -0,0 +1,146 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// RepositoryPipelineConfig is the pipelines configuration of a repository
+type RepositoryPipelineConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+func resourceRepositoryPipelineConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRepositoryPipelineConfigPut,
+		ReadWithoutTimeout:   resourceRepositoryPipelineConfigRead,
+		UpdateWithoutTimeout: resourceRepositoryPipelineConfigPut,
+		DeleteWithoutTimeout: resourceRepositoryPipelineConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func putRepositoryPipelineConfig(client *Client, workspace, repo string, enabled bool) error {
+	payload, err := json.Marshal(&RepositoryPipelineConfig{Enabled: enabled})
+	if err != nil {
+		return err
+	}
+
+	res, err := client.Put(fmt.Sprintf("2.0/repositories/%s/%s/pipelines_config", workspace, repo), bytes.NewBuffer(payload))
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+
+	return nil
+}
+
+func resourceRepositoryPipelineConfigPut(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+
+	if err := putRepositoryPipelineConfig(client, workspace, repo, d.Get("enabled").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(fmt.Sprintf("%s/%s", workspace, repo))
+	}
+
+	return resourceRepositoryPipelineConfigRead(ctx, d, m)
+}
+
+func resourceRepositoryPipelineConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repo, err := repositoryPipelineConfigId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var config RepositoryPipelineConfig
+	err = client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/repositories/%s/%s/pipelines_config", workspace, repo), nil, &config)
+
+	// Repositories that never had pipelines configured have no config yet,
+	// which is the same as pipelines being disabled. Only a missing
+	// repository removes the resource.
+	if IsNotFound(err) {
+		res, repoErr := client.Get(fmt.Sprintf("2.0/repositories/%s/%s", workspace, repo))
+		if IsNotFound(repoErr) {
+			log.Printf("[WARN] Repository Pipeline Config (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		if repoErr != nil {
+			return diag.FromErr(repoErr)
+		}
+		res.Body.Close()
+
+		config = RepositoryPipelineConfig{Enabled: false}
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("workspace", workspace)
+	d.Set("repository", repo)
+	d.Set("enabled", config.Enabled)
+
+	return nil
+}
+
+func resourceRepositoryPipelineConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repo, err := repositoryPipelineConfigId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The config can't be removed, turning pipelines off is the closest.
+	err = putRepositoryPipelineConfig(client, workspace, repo, false)
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func repositoryPipelineConfigId(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/REPO-SLUG", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,130 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBitbucketRepositoryPipelineConfig_basic(t *testing.T) {
+	resourceName := "bitbucket_repository_pipeline_config.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketRepositoryPipelineConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryPipelineConfigConfig(workspace, rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "workspace", workspace),
+					resource.TestCheckResourceAttrPair(resourceName, "repository", "bitbucket_repository.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketRepositoryPipelineConfigConfig(workspace, rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryPipelineConfigRead_neverConfigured(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2.0/repositories/example/repo/pipelines_config":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"type":"error","error":{"message":"Not found"}}`))
+		case "/2.0/repositories/example/repo":
+			w.Write([]byte(`{"slug":"repo"}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceRepositoryPipelineConfig().Schema, map[string]interface{}{
+		"enabled": true,
+	})
+	d.SetId("example/repo")
+
+	if diags := resourceRepositoryPipelineConfigRead(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() == "" {
+		t.Fatal("expected the resource to be kept when the repository exists")
+	}
+
+	if d.Get("enabled").(bool) {
+		t.Error("expected pipelines to be disabled when they were never configured")
+	}
+}
+
+func TestResourceRepositoryPipelineConfigRead_repositoryGone(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceRepositoryPipelineConfig().Schema, map[string]interface{}{})
+	d.SetId("example/repo")
+
+	if diags := resourceRepositoryPipelineConfigRead(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Error("expected the resource to be removed with its repository")
+	}
+}
+
+func testAccCheckBitbucketRepositoryPipelineConfigDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(Clients).httpClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bitbucket_repository_pipeline_config" {
+			continue
+		}
+
+		var config RepositoryPipelineConfig
+		err := client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/repositories/%s/%s/pipelines_config", rs.Primary.Attributes["workspace"], rs.Primary.Attributes["repository"]), nil, &config)
+		if err == nil && config.Enabled {
+			return fmt.Errorf("Pipelines are still enabled")
+		}
+	}
+	return nil
+}
+
+func testAccBitbucketRepositoryPipelineConfigConfig(workspace, rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+
+  lifecycle {
+    ignore_changes = [pipelines_enabled]
+  }
+}
+
+resource "bitbucket_repository_pipeline_config" "test" {
+  workspace  = %[1]q
+  repository = bitbucket_repository.test.name
+  enabled    = %[3]t
+}
+`, workspace, rName, enabled)
+}
@@ -1,13 +1,19 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -133,6 +139,23 @@ func TestAccBitbucketRepository_slug(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccBitbucketRepoSlugConfig(workspace, rName+"-renamed", rSlug),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-renamed"),
+					resource.TestCheckResourceAttr(resourceName, "slug", rSlug),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s", workspace, rSlug)),
+				),
+			},
+			{
+				Config: testAccBitbucketRepoSlugConfig(workspace, rName+"-renamed", rSlug+"-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "slug", rSlug+"-renamed"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s-renamed", workspace, rSlug)),
+				),
+			},
 		},
 	})
 }
@@ -183,6 +206,462 @@ func TestAccBitbucketRepository_inherit(t *testing.T) {
 	})
 }
 
+func TestAccBitbucketRepository_forkPolicy(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-test")
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	resourceName := "bitbucket_repository.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepoForkPolicyConfig(workspace, rName, "no_public_forks", "go"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "fork_policy", "no_public_forks"),
+					resource.TestCheckResourceAttr(resourceName, "language", "go"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketRepoForkPolicyConfig(workspace, rName, "no_forks", "Python"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "fork_policy", "no_forks"),
+					resource.TestCheckResourceAttr(resourceName, "language", "python"),
+				),
+			},
+			{
+				Config: testAccBitbucketRepoForkPolicyConfig(workspace, rName, "allow_forks", "Python"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "fork_policy", "allow_forks"),
+					resource.TestCheckResourceAttr(resourceName, "language", "python"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBitbucketRepository_mainBranch(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-test")
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	resourceName := "bitbucket_repository.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepoMainBranchConfig(workspace, rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketRepositoryExists(resourceName),
+				),
+			},
+			{
+				Config: testAccBitbucketRepoMainBranchConfig(workspace, rName, "develop"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "main_branch", "develop"),
+				),
+			},
+			{
+				Config: testAccBitbucketRepoMainBranchConfig(workspace, rName, "master"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "main_branch", "master"),
+				),
+			},
+			{
+				Config:      testAccBitbucketRepoMainBranchConfig(workspace, rName, "missing"),
+				ExpectError: regexp.MustCompile(`branch "missing" does not exist`),
+			},
+		},
+	})
+}
+
+func testAccBitbucketRepoMainBranchConfig(workspace, rName, mainBranch string) string {
+	branch := ""
+	if mainBranch != "" {
+		branch = fmt.Sprintf("main_branch = %q", mainBranch)
+	}
+
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+  %[3]s
+}
+
+resource "bitbucket_commit_file" "master" {
+  workspace      = %[1]q
+  repository     = bitbucket_repository.test.name
+  branch         = "master"
+  path           = "README.md"
+  content        = "main branch"
+  commit_message = "initial commit"
+}
+
+resource "bitbucket_commit_file" "develop" {
+  workspace      = %[1]q
+  repository     = bitbucket_repository.test.name
+  branch         = "develop"
+  path           = "README.md"
+  content        = "develop branch"
+  commit_message = "start develop"
+
+  depends_on = [bitbucket_commit_file.master]
+}
+`, workspace, rName, branch)
+}
+
+func TestAccBitbucketRepository_wikiWebsite(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-test")
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	resourceName := "bitbucket_repository.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepoWikiWebsiteConfig(workspace, rName, true, "https://example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "has_wiki", "true"),
+					resource.TestCheckResourceAttr(resourceName, "website", "https://example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketRepoWikiWebsiteConfig(workspace, rName, false, "https://example.org/docs"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "has_wiki", "false"),
+					resource.TestCheckResourceAttr(resourceName, "website", "https://example.org/docs"),
+				),
+			},
+		},
+	})
+}
+
+func TestPatchRepository_onlyChanges(t *testing.T) {
+	var method, path, payload string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		method, path, payload = r.Method, r.URL.Path, string(body)
+		w.Write([]byte(`{}`))
+	})
+
+	resourceSchema := resourceRepository().Schema
+	raw := map[string]interface{}{
+		"owner":       "example",
+		"name":        "repo",
+		"description": "old",
+		"has_issues":  true,
+		"language":    "go",
+	}
+
+	current := schema.TestResourceDataRaw(t, resourceSchema, raw)
+	current.SetId("example/repo")
+	state := current.State()
+
+	raw["description"] = "new"
+	diff, err := schema.InternalMap(resourceSchema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := schema.InternalMap(resourceSchema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := patchRepository(client, "example", "repo", d); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if method != http.MethodPatch || path != "/2.0/repositories/example/repo" {
+		t.Errorf("expected a PATCH of the repository, got %s %s", method, path)
+	}
+
+	if expected := `{"description":"new"}`; payload != expected {
+		t.Errorf("expected payload %s, got %s", expected, payload)
+	}
+}
+
+func TestPatchRepository_noChanges(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceRepository().Schema, map[string]interface{}{
+		"owner": "example",
+		"name":  "repo",
+	})
+	d.SetId("example/repo")
+
+	state := d.State()
+	unchanged, err := schema.InternalMap(resourceRepository().Schema).Data(state, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := patchRepository(client, "example", "repo", unchanged); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestPatchRepository_website(t *testing.T) {
+	var payload string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		payload = string(body)
+		w.Write([]byte(`{}`))
+	})
+
+	resourceSchema := resourceRepository().Schema
+	raw := map[string]interface{}{
+		"owner": "example",
+		"name":  "repo",
+	}
+
+	current := schema.TestResourceDataRaw(t, resourceSchema, raw)
+	current.SetId("example/repo")
+	state := current.State()
+
+	raw["has_wiki"] = true
+	raw["website"] = "https://example.com"
+	diff, err := schema.InternalMap(resourceSchema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := schema.InternalMap(resourceSchema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := patchRepository(client, "example", "repo", d); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := `{"has_wiki":true,"website":"https://example.com"}`; payload != expected {
+		t.Errorf("expected payload %s, got %s", expected, payload)
+	}
+}
+
+func TestPatchRepository_renamed(t *testing.T) {
+	var path string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write([]byte(`{"name":"New Name","slug":"new-name"}`))
+	})
+
+	resourceSchema := resourceRepository().Schema
+	raw := map[string]interface{}{
+		"owner": "example",
+		"name":  "repo",
+	}
+
+	current := schema.TestResourceDataRaw(t, resourceSchema, raw)
+	current.SetId("example/repo")
+	state := current.State()
+
+	raw["name"] = "New Name"
+	diff, err := schema.InternalMap(resourceSchema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := schema.InternalMap(resourceSchema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slug, err := patchRepository(client, "example", "repo", d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if path != "/2.0/repositories/example/repo" {
+		t.Errorf("expected the repository to be addressed by its old slug, got %s", path)
+	}
+
+	if slug != "new-name" {
+		t.Errorf("expected the slug assigned by Bitbucket, got %q", slug)
+	}
+}
+
+func TestPatchRepository_slugChanged(t *testing.T) {
+	var path, payload string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		path, payload = r.URL.Path, string(body)
+		w.Write([]byte(`{"name":"repo","slug":"new-slug"}`))
+	})
+
+	resourceSchema := resourceRepository().Schema
+	raw := map[string]interface{}{
+		"owner": "example",
+		"name":  "repo",
+		"slug":  "old-slug",
+	}
+
+	current := schema.TestResourceDataRaw(t, resourceSchema, raw)
+	current.SetId("example/old-slug")
+	state := current.State()
+
+	raw["slug"] = "new-slug"
+	diff, err := schema.InternalMap(resourceSchema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := schema.InternalMap(resourceSchema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slug, err := patchRepository(client, "example", "old-slug", d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if path != "/2.0/repositories/example/old-slug" {
+		t.Errorf("expected the repository to be addressed by its old slug, got %s", path)
+	}
+
+	if expected := `{"slug":"new-slug"}`; payload != expected {
+		t.Errorf("expected payload %s, got %s", expected, payload)
+	}
+
+	if slug != "new-slug" {
+		t.Errorf("expected the new slug, got %q", slug)
+	}
+}
+
+func TestResourceRepositoryDelete(t *testing.T) {
+	var requests []string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceRepository().Schema, map[string]interface{}{
+		"owner": "example",
+		"name":  "Repo Name",
+		"slug":  "new-slug",
+	})
+	d.SetId("example/old-slug")
+
+	if diags := resourceRepositoryDelete(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(requests) != 1 || requests[0] != "DELETE /2.0/repositories/example/old-slug" {
+		t.Errorf("expected the repository to be deleted by its ID, got %v", requests)
+	}
+}
+
+func TestRepositorySlugDiffSuppress(t *testing.T) {
+	suppress := resourceRepository().Schema["slug"].DiffSuppressFunc
+
+	cases := []struct {
+		old, new string
+		expected bool
+	}{
+		{"my-repo", "my-repo", true},
+		{"my-repo", "My_Repo", false},
+		{"my-repo", "My-Repo", true},
+		{"my-repo", "other-repo", false},
+	}
+
+	for _, c := range cases {
+		if got := suppress("slug", c.old, c.new, nil); got != c.expected {
+			t.Errorf("expected diff from %q to %q to be suppressed: %t, got %t", c.old, c.new, c.expected, got)
+		}
+	}
+}
+
+func TestSetRepositoryMainBranch(t *testing.T) {
+	var payload string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.EscapedPath() == "/2.0/repositories/example/repo/refs/branches/feature%2Fmain":
+			w.Write([]byte(`{"name":"feature/main"}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/2.0/repositories/example/repo":
+			body, _ := io.ReadAll(r.Body)
+			payload = string(body)
+			w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.EscapedPath())
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
+	if err := setRepositoryMainBranch(client, "example", "repo", "feature/main"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := `{"mainbranch":{"name":"feature/main"}}`; payload != expected {
+		t.Errorf("expected payload %s, got %s", expected, payload)
+	}
+}
+
+func TestSetRepositoryMainBranch_missingBranch(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected no %s for a missing branch", r.Method)
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"type":"error","error":{"message":"Branch not found"}}`))
+	})
+
+	err := setRepositoryMainBranch(client, "example", "repo", "missing")
+	if err == nil {
+		t.Fatal("expected an error for a missing branch")
+	}
+
+	if expected := `branch "missing" does not exist in repository example/repo`; !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected error to contain %q, got %q", expected, err)
+	}
+}
+
+func testAccBitbucketRepoForkPolicyConfig(workspace, rName, forkPolicy, language string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner       = %[1]q
+  name        = %[2]q
+  fork_policy = %[3]q
+  language    = %[4]q
+}
+`, workspace, rName, forkPolicy, language)
+}
+
+func testAccBitbucketRepoWikiWebsiteConfig(workspace, rName string, hasWiki bool, website string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner    = %[1]q
+  name     = %[2]q
+  has_wiki = %[3]t
+  website  = %[4]q
+}
+`, workspace, rName, hasWiki, website)
+}
+
 func testAccBitbucketRepoInheritConfig(workspace, rName string, enable bool) string {
 	return fmt.Sprintf(`
 resource "bitbucket_repository" "test" {
@@ -277,3 +756,40 @@ func testAccCheckBitbucketRepositoryExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestWaitForRepository(t *testing.T) {
+	defer func(interval time.Duration) { repositoryPollInterval = interval }(repositoryPollInterval)
+	repositoryPollInterval = 10 * time.Millisecond
+
+	var attempts int
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		fmt.Fprint(w, `{"slug":"repo"}`)
+	})
+
+	if err := waitForRepository(context.Background(), client, "example", "repo", time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if attempts != 3 {
+		t.Errorf("expected the repository to be polled 3 times, got %d", attempts)
+	}
+}
+
+func TestWaitForRepository_timeout(t *testing.T) {
+	defer func(interval time.Duration) { repositoryPollInterval = interval }(repositoryPollInterval)
+	repositoryPollInterval = 10 * time.Millisecond
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if err := waitForRepository(context.Background(), client, "example", "repo", 100*time.Millisecond); err == nil {
+		t.Fatal("expected an error for a repository that never gets ready")
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -122,7 +121,7 @@ func resourceRepositoryUserPermissionRead(ctx context.Context, d *schema.Resourc
 		userSlug,
 	))
 
-	if permissionReq.StatusCode == http.StatusNotFound {
+	if IsNotFound(err) {
 		log.Printf("[WARN] Repository User Permission (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -149,7 +148,9 @@ func resourceRepositoryUserPermissionRead(ctx context.Context, d *schema.Resourc
 	log.Printf("Repository User Permission decoded is: %#v", permission)
 
 	d.Set("permission", permission.Permission)
-	d.Set("user_id", permission.User.UUID)
+	// Keep the identifier from the ID, the API always answers with the UUID
+	// even when the permission was granted by account ID.
+	d.Set("user_id", userSlug)
 	d.Set("workspace", workspace)
 	d.Set("repo_slug", repoSlug)
 
@@ -173,12 +174,16 @@ func resourceRepositoryUserPermissionDelete(ctx context.Context, d *schema.Resou
 	return diag.FromErr(err)
 }
 
+// repositoryUserPermissionId splits at most three parts so account IDs, which
+// contain a colon themselves, survive. WORKSPACE/REPO-SLUG/USER-ID is accepted
+// as well for imports.
 func repositoryUserPermissionId(id string) (string, string, string, error) {
-	parts := strings.Split(id, ":")
-
-	if len(parts) != 3 {
-		return "", "", "", fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE:REPO-SLUG:GROUP-SLUG", id)
+	for _, sep := range []string{":", "/"} {
+		parts := strings.SplitN(id, sep, 3)
+		if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+			return parts[0], parts[1], parts[2], nil
+		}
 	}
 
-	return parts[0], parts[1], parts[2], nil
+	return "", "", "", fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE:REPO-SLUG:USER-ID", id)
 }
@@ -102,3 +102,25 @@ resource "bitbucket_repository_user_permission" "test" {
 }
 `, workspace, rName, permission)
 }
+
+func TestRepositoryUserPermissionId(t *testing.T) {
+	cases := map[string][3]string{
+		"ws:repo:{d7dd0e2d-3994-4a50-a9ee-d260b6cefdab}": {"ws", "repo", "{d7dd0e2d-3994-4a50-a9ee-d260b6cefdab}"},
+		"ws:repo:557058:c0b72ad0-1cb5-4018":              {"ws", "repo", "557058:c0b72ad0-1cb5-4018"},
+		"ws/repo/557058:c0b72ad0-1cb5-4018":              {"ws", "repo", "557058:c0b72ad0-1cb5-4018"},
+	}
+
+	for id, expected := range cases {
+		workspace, repoSlug, userId, err := repositoryUserPermissionId(id)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", id, err)
+		}
+		if got := [3]string{workspace, repoSlug, userId}; got != expected {
+			t.Errorf("expected %q to be parsed as %v, got %v", id, expected, got)
+		}
+	}
+
+	if _, _, _, err := repositoryUserPermissionId("ws:repo"); err == nil {
+		t.Error("expected an error for an ID without a user")
+	}
+}
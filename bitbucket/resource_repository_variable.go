@@ -18,6 +18,24 @@ func resourceRepositoryVariable() *schema.Resource {
 		UpdateWithoutTimeout: resourceRepositoryVariableUpdate,
 		ReadWithoutTimeout:   resourceRepositoryVariableRead,
 		DeleteWithoutTimeout: resourceRepositoryVariableDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/REPO-SLUG/VARIABLE-UUID", d.Id())
+				}
+				c := meta.(Clients).genClient
+				rvRes, _, err := c.ApiClient.PipelinesApi.GetRepositoryPipelineVariable(c.AuthContext, idParts[0], idParts[1], idParts[2])
+				if err := handleClientError(err); err != nil {
+					return nil, err
+				}
+
+				d.SetId(rvRes.Key)
+				d.Set("uuid", rvRes.Uuid)
+				d.Set("repository", strings.Join([]string{idParts[0], idParts[1]}, "/"))
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"uuid": {
@@ -41,6 +59,7 @@ func resourceRepositoryVariable() *schema.Resource {
 			"repository": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 		},
 	}
@@ -89,7 +108,7 @@ func resourceRepositoryVariableRead(ctx context.Context, d *schema.ResourceData,
 
 	rvRes, res, err := pipeApi.GetRepositoryPipelineVariable(c.AuthContext, workspace, repoSlug, d.Get("uuid").(string))
 
-	if res.StatusCode == http.StatusNotFound {
+	if res != nil && res.StatusCode == http.StatusNotFound {
 		log.Printf("[WARN] Repository Variable (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -42,10 +42,27 @@ func TestAccBitbucketRepositoryVariable_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "secured", "false"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccBitbucketRepositoryVariableImportStateIdFunc(resourceName),
+			},
 		},
 	})
 }
 
+func testAccBitbucketRepositoryVariableImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["repository"], rs.Primary.Attributes["uuid"]), nil
+	}
+}
+
 func testAccCheckBitbucketRepositoryVariableDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(Clients).genClient
 	pipeApi := client.ApiClient.PipelinesApi
@@ -0,0 +1,264 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type Snippet struct {
+	ID        string                 `json:"id"`
+	Title     string                 `json:"title"`
+	IsPrivate bool                   `json:"is_private"`
+	Files     map[string]interface{} `json:"files,omitempty"`
+}
+
+func resourceSnippet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSnippetCreate,
+		ReadWithoutTimeout:   resourceSnippetRead,
+		UpdateWithoutTimeout: resourceSnippetUpdate,
+		DeleteWithoutTimeout: resourceSnippetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"is_private": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"file": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"content": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"snippet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSnippetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+	workspace := d.Get("workspace").(string)
+
+	payload, contentType, err := expandSnippetPayload(d, expandSnippetFiles(d.Get("file").(*schema.Set)), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	snippetRes, err := client.PostMultipart(fmt.Sprintf("2.0/snippets/%s", workspace), contentType, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var snippet Snippet
+	err = json.NewDecoder(snippetRes.Body).Decode(&snippet)
+	snippetRes.Body.Close()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Snippet create res decoded: %#v", snippet)
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, snippet.ID))
+
+	return resourceSnippetRead(ctx, d, m)
+}
+
+func resourceSnippetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, id, err := snippetId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var snippet Snippet
+	err = client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/snippets/%s/%s", workspace, id), nil, &snippet)
+
+	if IsNotFound(err) {
+		log.Printf("[WARN] Snippet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var files []interface{}
+	for name := range snippet.Files {
+		fileRes, err := client.Get(fmt.Sprintf("2.0/snippets/%s/%s/files/%s", workspace, id, url.PathEscape(name)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		content, err := io.ReadAll(fileRes.Body)
+		fileRes.Body.Close()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		files = append(files, map[string]interface{}{
+			"name":    name,
+			"content": string(content),
+		})
+	}
+
+	d.Set("workspace", workspace)
+	d.Set("snippet_id", snippet.ID)
+	d.Set("title", snippet.Title)
+	d.Set("is_private", snippet.IsPrivate)
+	d.Set("file", files)
+
+	return nil
+}
+
+func resourceSnippetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, id, err := snippetId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	o, n := d.GetChange("file")
+	files := expandSnippetFiles(n.(*schema.Set))
+
+	// Files that are no longer configured have to be deleted explicitly,
+	// files left out of the request are kept as they are.
+	var removed []string
+	for name := range expandSnippetFiles(o.(*schema.Set)) {
+		if _, ok := files[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	payload, contentType, err := expandSnippetPayload(d, files, removed)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := client.DoMultipart(http.MethodPut, fmt.Sprintf("2.0/snippets/%s/%s", workspace, id), contentType, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res.Body.Close()
+
+	return resourceSnippetRead(ctx, d, m)
+}
+
+func resourceSnippetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, id, err := snippetId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Delete(fmt.Sprintf("2.0/snippets/%s/%s", workspace, id))
+
+	return diag.FromErr(err)
+}
+
+func expandSnippetFiles(set *schema.Set) map[string]string {
+	files := make(map[string]string, set.Len())
+	for _, v := range set.List() {
+		file := v.(map[string]interface{})
+		files[file["name"].(string)] = file["content"].(string)
+	}
+
+	return files
+}
+
+// expandSnippetPayload builds the multipart snippet request. Files are
+// uploaded as file parts, a plain file field holding only the name deletes
+// that file.
+func expandSnippetPayload(d *schema.ResourceData, files map[string]string, removed []string) (*bytes.Buffer, string, error) {
+	var payload bytes.Buffer
+	writer := multipart.NewWriter(&payload)
+
+	if err := writer.WriteField("title", d.Get("title").(string)); err != nil {
+		return nil, "", err
+	}
+
+	if err := writer.WriteField("is_private", strconv.FormatBool(d.Get("is_private").(bool))); err != nil {
+		return nil, "", err
+	}
+
+	for name, content := range files {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename=%q`, name))
+		header.Set("Content-Type", "text/plain")
+
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if _, err := io.WriteString(part, content); err != nil {
+			return nil, "", err
+		}
+	}
+
+	for _, name := range removed {
+		if err := writer.WriteField("file", name); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return &payload, writer.FormDataContentType(), nil
+}
+
+func snippetId(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/SNIPPET-ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,162 @@
+package bitbucket
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBitbucketSnippet_basic(t *testing.T) {
+	resourceName := "bitbucket_snippet.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketSnippetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketSnippetConfig(workspace, rName, "echo hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketSnippetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "title", rName),
+					resource.TestCheckResourceAttr(resourceName, "is_private", "true"),
+					resource.TestCheckResourceAttr(resourceName, "file.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "file.*", map[string]string{
+						"name":    "hello.sh",
+						"content": "echo hello",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "snippet_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketSnippetConfig(workspace, rName, "echo updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketSnippetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "file.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "file.*", map[string]string{
+						"name":    "hello.sh",
+						"content": "echo updated",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandSnippetPayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSnippet().Schema, map[string]interface{}{
+		"workspace":  "example",
+		"title":      "test",
+		"is_private": true,
+	})
+
+	payload, contentType, err := expandSnippetPayload(d, map[string]string{"hello.sh": "echo hello"}, []string{"old.sh"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		t.Fatalf("unexpected content type %q: %s", contentType, err)
+	}
+
+	fields := map[string]string{}
+	files := map[string]string{}
+	var removed []string
+	reader := multipart.NewReader(payload, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		content, _ := io.ReadAll(part)
+		switch {
+		case part.FormName() == "file" && part.FileName() == "":
+			removed = append(removed, string(content))
+		case part.FormName() == "file":
+			files[part.FileName()] = string(content)
+		default:
+			fields[part.FormName()] = string(content)
+		}
+	}
+
+	if fields["title"] != "test" || fields["is_private"] != "true" {
+		t.Errorf("unexpected fields %v", fields)
+	}
+
+	if len(files) != 1 || files["hello.sh"] != "echo hello" {
+		t.Errorf("unexpected files %v", files)
+	}
+
+	// a removed file is a plain field with its name, not an empty upload
+	if len(removed) != 1 || removed[0] != "old.sh" {
+		t.Errorf("expected old.sh to be deleted, got %v", removed)
+	}
+}
+
+func testAccCheckBitbucketSnippetDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(Clients).httpClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bitbucket_snippet" {
+			continue
+		}
+
+		_, err := client.Get(fmt.Sprintf("2.0/snippets/%s", rs.Primary.ID))
+
+		if err == nil {
+			return fmt.Errorf("The resource was found should have errored")
+		}
+
+		if !IsNotFound(err) {
+			return fmt.Errorf("Snippet still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckBitbucketSnippetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Snippet ID is set")
+		}
+		return nil
+	}
+}
+
+func testAccBitbucketSnippetConfig(workspace, rName, content string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_snippet" "test" {
+  workspace  = %[1]q
+  title      = %[2]q
+  is_private = true
+
+  file {
+    name    = "hello.sh"
+    content = %[3]q
+  }
+}
+`, workspace, rName, content)
+}
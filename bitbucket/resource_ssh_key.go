@@ -39,9 +39,10 @@ func resourceSshKey() *schema.Resource {
 				ForceNew: true,
 			},
 			"key": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentSSHKeys,
 			},
 			"label": {
 				Type:     schema.TypeString,
@@ -91,7 +92,7 @@ func resourceSshKeysRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	sshKeyReq, res, err := sshApi.UsersSelectedUserSshKeysKeyIdGet(c.AuthContext, keyId, user)
 
-	if res.StatusCode == http.StatusNotFound {
+	if res != nil && res.StatusCode == http.StatusNotFound {
 		log.Printf("[WARN] SSH Key (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -106,7 +107,7 @@ func resourceSshKeysRead(ctx context.Context, d *schema.ResourceData, m interfac
 	}
 
 	d.Set("user", user)
-	d.Set("key", d.Get("key").(string))
+	setSSHKey(d, sshKeyReq.Key)
 	d.Set("label", sshKeyReq.Label)
 	d.Set("uuid", sshKeyReq.Uuid)
 	d.Set("comment", sshKeyReq.Comment)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -149,3 +150,42 @@ resource "bitbucket_ssh_key" "test" {
 }
 `, pubkey, label)
 }
+
+func TestNormalizeSSHKey(t *testing.T) {
+	const key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKqP3Cr632C2dNhhgKVcon4ldUSAeKiku2yP9O9/bDtY"
+
+	for _, input := range []string{
+		key,
+		key + " user@example.com",
+		"  " + key + "  user@example.com\n",
+	} {
+		if got := normalizeSSHKey(input); got != key {
+			t.Errorf("normalizeSSHKey(%q) = %q, want %q", input, got, key)
+		}
+	}
+
+	if got := normalizeSSHKey(" invalid "); got != "invalid" {
+		t.Errorf("expected a single field to be trimmed, got %q", got)
+	}
+}
+
+func TestSetSSHKey(t *testing.T) {
+	const key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKqP3Cr632C2dNhhgKVcon4ldUSAeKiku2yP9O9/bDtY"
+
+	configured := schema.TestResourceDataRaw(t, resourceSshKey().Schema, map[string]interface{}{
+		"user": "{user}",
+		"key":  key + " user@example.com",
+	})
+	setSSHKey(configured, key)
+
+	if got := configured.Get("key").(string); got != key+" user@example.com" {
+		t.Errorf("expected the configured key with its comment to be kept, got %q", got)
+	}
+
+	imported := schema.TestResourceDataRaw(t, resourceSshKey().Schema, map[string]interface{}{})
+	setSSHKey(imported, " "+key+"\n")
+
+	if got := imported.Get("key").(string); got != key {
+		t.Errorf("expected the returned key after an import, got %q", got)
+	}
+}
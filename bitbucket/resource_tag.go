@@ -0,0 +1,144 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTag() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTagCreate,
+		ReadWithoutTimeout:   resourceTagRead,
+		DeleteWithoutTimeout: resourceTagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTagCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+	// Bitbucket creates an annotated tag when a message is given and a
+	// lightweight one otherwise.
+	tag := &RepositoryRef{
+		Name:    d.Get("name").(string),
+		Message: d.Get("message").(string),
+		Target: &RepositoryRefHash{
+			Hash: d.Get("target").(string),
+		},
+	}
+
+	bytedata, err := json.Marshal(tag)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.DoAndDecode(http.MethodPost, fmt.Sprintf("2.0/repositories/%s/%s/refs/tags", workspace, repo), bytes.NewBuffer(bytedata), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", workspace, repo, tag.Name))
+
+	return resourceTagRead(ctx, d, m)
+}
+
+func resourceTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repo, name, err := repositoryRefId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var tag RepositoryRef
+	err = client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/repositories/%s/%s/refs/tags/%s", workspace, repo, name), nil, &tag)
+
+	if IsNotFound(err) {
+		log.Printf("[WARN] Tag (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("workspace", workspace)
+	d.Set("repository", repo)
+	d.Set("name", tag.Name)
+
+	// git terminates tag messages with a newline.
+	if strings.TrimSpace(tag.Message) != strings.TrimSpace(d.Get("message").(string)) {
+		d.Set("message", tag.Message)
+	}
+
+	if tag.Target != nil {
+		d.Set("hash", tag.Target.Hash)
+
+		if d.Get("target").(string) == "" {
+			d.Set("target", tag.Target.Hash)
+		}
+	}
+
+	return nil
+}
+
+func resourceTagDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repo, name, err := repositoryRefId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Delete(fmt.Sprintf("2.0/repositories/%s/%s/refs/tags/%s", workspace, repo, name))
+
+	return diag.FromErr(err)
+}
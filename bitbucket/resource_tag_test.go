@@ -0,0 +1,100 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBitbucketTag_basic(t *testing.T) {
+	resourceName := "bitbucket_tag.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	// tags need a commit to point at, so an existing repository is used
+	repo := os.Getenv("BITBUCKET_PIPELINED_REPO")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckPipeSchedule(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketTagConfig(workspace, repo, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketTagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "workspace", workspace),
+					resource.TestCheckResourceAttr(resourceName, "repository", repo),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "target", "data.bitbucket_repository.test", "mainbranch"),
+					resource.TestCheckResourceAttrSet(resourceName, "hash"),
+					resource.TestCheckResourceAttr(resourceName, "message", "release "+rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"target"},
+			},
+		},
+	})
+}
+
+func testAccCheckBitbucketTagDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(Clients).httpClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bitbucket_tag" {
+			continue
+		}
+
+		_, err := client.Get(fmt.Sprintf("2.0/repositories/%s/%s/refs/tags/%s", rs.Primary.Attributes["workspace"], rs.Primary.Attributes["repository"], rs.Primary.Attributes["name"]))
+
+		if err == nil {
+			return fmt.Errorf("The resource was found should have errored")
+		}
+
+		if !IsNotFound(err) {
+			return fmt.Errorf("Tag still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckBitbucketTagExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Tag ID is set")
+		}
+		return nil
+	}
+}
+
+func testAccBitbucketTagConfig(workspace, repo, name string) string {
+	message := "release " + name
+
+	return fmt.Sprintf(`
+data "bitbucket_repository" "test" {
+  workspace = %[1]q
+  slug      = %[2]q
+}
+
+resource "bitbucket_tag" "test" {
+  workspace  = %[1]q
+  repository = %[2]q
+  name       = %[3]q
+  target     = data.bitbucket_repository.test.mainbranch
+  message    = %[4]q
+}
+`, workspace, repo, name, message)
+}
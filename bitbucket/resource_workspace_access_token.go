@@ -0,0 +1,71 @@
+package bitbucket
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Workspace tokens can do everything a project token can across the whole
+// workspace, and also reach the workspace level features like members,
+// snippets, issues and wikis.
+var workspaceAccessTokenScopes = append([]string{
+	"account",
+	"snippet",
+	"snippet:write",
+	"issue",
+	"issue:write",
+	"wiki",
+}, projectAccessTokenScopes...)
+
+var workspaceAccessTokenOwner = accessTokenOwner{
+	kind:     "Workspace Access Token",
+	keys:     []string{"workspace"},
+	idFormat: "WORKSPACE/TOKEN-ID",
+	endpoint: func(keys []string) string {
+		return fmt.Sprintf("2.0/workspaces/%s/access-tokens", keys[0])
+	},
+}
+
+func resourceWorkspaceAccessToken() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: workspaceAccessTokenOwner.create,
+		ReadWithoutTimeout:   workspaceAccessTokenOwner.read,
+		UpdateWithoutTimeout: workspaceAccessTokenOwner.update,
+		DeleteWithoutTimeout: workspaceAccessTokenOwner.delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"scopes": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(workspaceAccessTokenScopes, false),
+				},
+			},
+			"token_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
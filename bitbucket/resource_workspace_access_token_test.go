@@ -0,0 +1,113 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBitbucketWorkspaceAccessToken_basic(t *testing.T) {
+	resourceName := "bitbucket_workspace_access_token.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketWorkspaceAccessTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketWorkspaceAccessTokenConfig(workspace, rName, "repository"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketWorkspaceAccessTokenExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "workspace", workspace),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "repository"),
+					resource.TestCheckResourceAttrSet(resourceName, "token_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+				),
+			},
+			{
+				Config: testAccBitbucketWorkspaceAccessTokenConfig(workspace, rName+"-updated", "project"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketWorkspaceAccessTokenExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "project"),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func TestWorkspaceAccessTokenScopes(t *testing.T) {
+	validate := resourceWorkspaceAccessToken().Schema["scopes"].Elem.(*schema.Schema).ValidateFunc
+
+	for scope, valid := range map[string]bool{
+		"account":    true,
+		"wiki":       true,
+		"project":    true,
+		"repository": true,
+		"admin":      false,
+	} {
+		if _, errs := validate(scope, "scopes"); valid != (len(errs) == 0) {
+			t.Errorf("%q: expected valid=%t, got %v", scope, valid, errs)
+		}
+	}
+}
+
+func testAccCheckBitbucketWorkspaceAccessTokenDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(Clients).httpClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bitbucket_workspace_access_token" {
+			continue
+		}
+
+		_, err := client.Get(fmt.Sprintf("2.0/workspaces/%s/access-tokens/%s", rs.Primary.Attributes["workspace"], rs.Primary.Attributes["token_id"]))
+
+		if err == nil {
+			return fmt.Errorf("The resource was found should have errored")
+		}
+
+		if !IsNotFound(err) {
+			return fmt.Errorf("Workspace Access Token still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckBitbucketWorkspaceAccessTokenExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Workspace Access Token ID is set")
+		}
+		return nil
+	}
+}
+
+func testAccBitbucketWorkspaceAccessTokenConfig(workspace, name, scope string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_workspace_access_token" "test" {
+  workspace = %[1]q
+  name      = %[2]q
+  scopes    = [%[3]q]
+}
+`, workspace, name, scope)
+}
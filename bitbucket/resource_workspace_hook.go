@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"net/http"
 	"net/url"
 	"strings"
 
@@ -16,6 +15,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// WorkspaceHook is the hook you want to add to a bitbucket workspace. Unlike
+// repository hooks it has neither a secret nor a request history.
+type WorkspaceHook struct {
+	UUID                 string   `json:"uuid,omitempty"`
+	URL                  string   `json:"url,omitempty"`
+	Description          string   `json:"description,omitempty"`
+	Active               bool     `json:"active"`
+	SkipCertVerification bool     `json:"skip_cert_verification"`
+	Events               []string `json:"events,omitempty"`
+}
+
 func resourceWorkspaceHook() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceWorkspaceHookCreate,
@@ -100,9 +110,25 @@ func resourceWorkspaceHook() *schema.Resource {
 	}
 }
 
+func createWorkspaceHook(d *schema.ResourceData) *WorkspaceHook {
+	events := make([]string, 0, len(d.Get("events").(*schema.Set).List()))
+
+	for _, item := range d.Get("events").(*schema.Set).List() {
+		events = append(events, item.(string))
+	}
+
+	return &WorkspaceHook{
+		URL:                  d.Get("url").(string),
+		Description:          d.Get("description").(string),
+		Active:               d.Get("active").(bool),
+		SkipCertVerification: d.Get("skip_cert_verification").(bool),
+		Events:               events,
+	}
+}
+
 func resourceWorkspaceHookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(Clients).httpClient
-	hook := createHook(d)
+	hook := createWorkspaceHook(d)
 
 	payload, err := json.Marshal(hook)
 	if err != nil {
@@ -139,7 +165,7 @@ func resourceWorkspaceHookRead(ctx context.Context, d *schema.ResourceData, m in
 		url.PathEscape(d.Id()),
 	))
 
-	if hookReq.StatusCode == http.StatusNotFound {
+	if IsNotFound(err) {
 		log.Printf("[WARN] Repository Hook (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -177,7 +203,7 @@ func resourceWorkspaceHookRead(ctx context.Context, d *schema.ResourceData, m in
 
 func resourceWorkspaceHookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(Clients).httpClient
-	hook := createHook(d)
+	hook := createWorkspaceHook(d)
 	payload, err := json.Marshal(hook)
 	if err != nil {
 		return diag.FromErr(err)
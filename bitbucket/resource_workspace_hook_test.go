@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -69,6 +71,27 @@ func TestAccBitbucketWorkspaceHook_basic(t *testing.T) {
 	})
 }
 
+func TestCreateWorkspaceHook(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceWorkspaceHook().Schema, map[string]interface{}{
+		"workspace":              "example",
+		"url":                    "https://site.internal/",
+		"description":            "Test description",
+		"events":                 []interface{}{"repo:push"},
+		"skip_cert_verification": true,
+	})
+
+	payload, err := json.Marshal(createWorkspaceHook(d))
+	if err != nil {
+		t.Fatalf("Failed to encode hook, %s", err)
+	}
+
+	// workspace hooks have no secret or history_enabled
+	expected := `{"url":"https://site.internal/","description":"Test description","active":true,"skip_cert_verification":true,"events":["repo:push"]}`
+	if string(payload) != expected {
+		t.Errorf("expected payload %s, got %s", expected, payload)
+	}
+}
+
 func testAccCheckBitbucketWorkspaceHookDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(Clients).httpClient
 	for _, rs := range s.RootModule().Resources {
@@ -0,0 +1,170 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/DrFaust92/bitbucket-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceWorkspaceVariable() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWorkspaceVariableCreate,
+		ReadWithoutTimeout:   resourceWorkspaceVariableRead,
+		UpdateWithoutTimeout: resourceWorkspaceVariableUpdate,
+		DeleteWithoutTimeout: resourceWorkspaceVariableDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"value": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"secured": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func expandWorkspaceVariable(d *schema.ResourceData) *bitbucket.PipelineVariable {
+	return &bitbucket.PipelineVariable{
+		Key:     d.Get("key").(string),
+		Value:   d.Get("value").(string),
+		Secured: d.Get("secured").(bool),
+	}
+}
+
+func resourceWorkspaceVariableCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+
+	bytedata, err := json.Marshal(expandWorkspaceVariable(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var variable bitbucket.PipelineVariable
+	err = client.DoAndDecode(http.MethodPost, fmt.Sprintf("2.0/workspaces/%s/pipelines-config/variables", workspace), bytes.NewBuffer(bytedata), &variable)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, variable.Uuid))
+
+	return resourceWorkspaceVariableRead(ctx, d, m)
+}
+
+func resourceWorkspaceVariableRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, uuid, err := workspaceVariableId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var variable bitbucket.PipelineVariable
+	err = client.DoAndDecode(http.MethodGet, fmt.Sprintf("2.0/workspaces/%s/pipelines-config/variables/%s", workspace, url.PathEscape(uuid)), nil, &variable)
+
+	if IsNotFound(err) {
+		log.Printf("[WARN] Workspace Variable (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("workspace", workspace)
+	d.Set("uuid", variable.Uuid)
+	d.Set("key", variable.Key)
+	d.Set("secured", variable.Secured)
+
+	// Secured values are never returned, keep the one in state.
+	if !variable.Secured {
+		d.Set("value", variable.Value)
+	}
+
+	return nil
+}
+
+func resourceWorkspaceVariableUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, uuid, err := workspaceVariableId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	variable := expandWorkspaceVariable(d)
+	variable.Uuid = uuid
+
+	bytedata, err := json.Marshal(variable)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := client.Put(fmt.Sprintf("2.0/workspaces/%s/pipelines-config/variables/%s", workspace, url.PathEscape(uuid)), bytes.NewBuffer(bytedata))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res.Body.Close()
+
+	return resourceWorkspaceVariableRead(ctx, d, m)
+}
+
+func resourceWorkspaceVariableDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, uuid, err := workspaceVariableId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Delete(fmt.Sprintf("2.0/workspaces/%s/pipelines-config/variables/%s", workspace, url.PathEscape(uuid)))
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func workspaceVariableId(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/VARIABLE-UUID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
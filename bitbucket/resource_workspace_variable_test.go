@@ -0,0 +1,149 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBitbucketWorkspaceVariable_basic(t *testing.T) {
+	resourceName := "bitbucket_workspace_variable.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := strings.ToUpper(strings.ReplaceAll(acctest.RandomWithPrefix("tf_test"), "-", "_"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketWorkspaceVariableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketWorkspaceVariableConfig(workspace, rName, "plain", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketWorkspaceVariableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "workspace", workspace),
+					resource.TestCheckResourceAttr(resourceName, "key", rName),
+					resource.TestCheckResourceAttr(resourceName, "value", "plain"),
+					resource.TestCheckResourceAttr(resourceName, "secured", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "uuid"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketWorkspaceVariableConfig(workspace, rName, "secret", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBitbucketWorkspaceVariableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "secret"),
+					resource.TestCheckResourceAttr(resourceName, "secured", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+		},
+	})
+}
+
+func TestResourceWorkspaceVariableRead_secured(t *testing.T) {
+	var path string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		fmt.Fprint(w, `{"uuid":"{var}","key":"TOKEN","secured":true}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceWorkspaceVariable().Schema, map[string]interface{}{
+		"workspace": "example",
+		"key":       "TOKEN",
+		"value":     "secret",
+		"secured":   true,
+	})
+	d.SetId("example/{var}")
+
+	if diags := resourceWorkspaceVariableRead(context.Background(), d, Clients{httpClient: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if expected := "/2.0/workspaces/example/pipelines-config/variables/" + url.PathEscape("{var}"); path != expected {
+		t.Errorf("expected a GET of %s, got %s", expected, path)
+	}
+
+	if value := d.Get("value").(string); value != "secret" {
+		t.Errorf("expected the secured value in state to be kept, got %q", value)
+	}
+
+	if uuid := d.Get("uuid").(string); uuid != "{var}" {
+		t.Errorf("expected uuid {var}, got %q", uuid)
+	}
+}
+
+func TestWorkspaceVariableId(t *testing.T) {
+	workspace, uuid, err := workspaceVariableId("example/{var}")
+	if err != nil || workspace != "example" || uuid != "{var}" {
+		t.Errorf("unexpected result %q, %q, %v", workspace, uuid, err)
+	}
+
+	for _, id := range []string{"example", "example/", "/{var}", "example/repo/{var}"} {
+		if _, _, err := workspaceVariableId(id); err == nil {
+			t.Errorf("expected %q to be invalid", id)
+		}
+	}
+}
+
+func testAccCheckBitbucketWorkspaceVariableDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(Clients).httpClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bitbucket_workspace_variable" {
+			continue
+		}
+
+		_, err := client.Get(fmt.Sprintf("2.0/workspaces/%s/pipelines-config/variables/%s", rs.Primary.Attributes["workspace"], url.PathEscape(rs.Primary.Attributes["uuid"])))
+
+		if err == nil {
+			return fmt.Errorf("The resource was found should have errored")
+		}
+
+		if !IsNotFound(err) {
+			return fmt.Errorf("Workspace Variable still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckBitbucketWorkspaceVariableExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Workspace Variable ID is set")
+		}
+		return nil
+	}
+}
+
+func testAccBitbucketWorkspaceVariableConfig(workspace, key, value string, secured bool) string {
+	return fmt.Sprintf(`
+resource "bitbucket_workspace_variable" "test" {
+  workspace = %[1]q
+  key       = %[2]q
+  value     = %[3]q
+  secured   = %[4]t
+}
+`, workspace, key, value, secured)
+}
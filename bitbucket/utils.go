@@ -8,7 +8,9 @@ import (
 	"encoding/pem"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"golang.org/x/crypto/ssh"
 )
 
@@ -64,3 +66,61 @@ func pemEncode(b []byte, block string) (string, error) {
 
 	return buf.String(), nil
 }
+
+// normalizeSSHKey reduces an OpenSSH public key to its type and key material.
+// Bitbucket strips the comment and surrounding whitespace of keys it stores,
+// so only these parts are meaningful when comparing against configuration.
+func normalizeSSHKey(key string) string {
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return strings.TrimSpace(key)
+	}
+
+	return fields[0] + " " + fields[1]
+}
+
+func suppressEquivalentSSHKeys(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeSSHKey(old) == normalizeSSHKey(new)
+}
+
+// setSSHKey stores the key Bitbucket returns. The API drops the comment of the
+// key, so the configured value is kept unless there is none yet, e.g. after an
+// import.
+func setSSHKey(d *schema.ResourceData, key string) {
+	if d.Get("key").(string) == "" {
+		d.Set("key", normalizeSSHKey(key))
+	}
+}
+
+// normalizeGPGKey drops the surrounding whitespace and carriage returns of an
+// ASCII armored key, heredocs and files usually end in a newline Bitbucket
+// doesn't keep.
+func normalizeGPGKey(key string) string {
+	return strings.TrimSpace(strings.ReplaceAll(key, "\r\n", "\n"))
+}
+
+func suppressEquivalentGPGKeys(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeGPGKey(old) == normalizeGPGKey(new)
+}
+
+// suppressCaseDifferences ignores values that only differ in case, Bitbucket
+// lowercases some settings such as the repository language.
+func suppressCaseDifferences(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+// validateDuration checks a value is a non-negative duration such as "500ms"
+// or "1m30s".
+func validateDuration(v interface{}, k string) (ws []string, errs []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%q must be a duration like \"10s\", got %q", k, v))
+		return
+	}
+
+	if duration < 0 {
+		errs = append(errs, fmt.Errorf("%q must not be negative, got %q", k, v))
+	}
+
+	return
+}